@@ -0,0 +1,115 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package logif
+
+import (
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LevelChange describes a change of an AtomicLevel.
+type LevelChange struct {
+	From, To LogLevel
+	// By identifies who changed the level: the name given to SetLevelBy,
+	// or the file and line number of the caller of SetLevel.
+	By   string
+	Time time.Time
+}
+
+// AtomicLevel is an output level which can be shared by several loggers,
+// so that changing it once changes all of them.
+//
+// It may be used simultaneously from multiple goroutines.
+type AtomicLevel struct {
+	level int32
+
+	mu   sync.Mutex // protects subs and next
+	subs []subscriber
+	next int
+}
+
+type subscriber struct {
+	id int
+	f  func(LevelChange)
+}
+
+// NewAtomicLevel creates an AtomicLevel set to level, clamped with
+// ClampLevel.
+func NewAtomicLevel(level LogLevel) *AtomicLevel {
+	return &AtomicLevel{level: int32(ClampLevel(level))}
+}
+
+// Level returns the level.
+func (a *AtomicLevel) Level() LogLevel {
+	return LogLevel(atomic.LoadInt32(&a.level))
+}
+
+// Enabled reports whether a message of the level is written at the level.
+func (a *AtomicLevel) Enabled(level LogLevel) bool {
+	return level >= a.Level() && level != OFF
+}
+
+// SetLevel sets the level, noting the caller as who changed it.
+// The level is clamped with ClampLevel.
+func (a *AtomicLevel) SetLevel(level LogLevel) {
+	by := "???"
+	if _, file, line, ok := runtime.Caller(1); ok {
+		by = file + ":" + strconv.Itoa(line)
+	}
+
+	a.SetLevelBy(level, by)
+}
+
+// SetLevelBy sets the level, noting by as who changed it.
+// The level is clamped with ClampLevel.
+//
+// The subscribers are called when the level is changed, one after the
+// other before SetLevelBy returns. They may read or change the level;
+// changes made concurrently may be notified out of order.
+func (a *AtomicLevel) SetLevelBy(level LogLevel, by string) {
+	level = ClampLevel(level)
+
+	a.mu.Lock()
+	from := LogLevel(atomic.SwapInt32(&a.level, int32(level)))
+	// the elements of subs are never modified in place, so that the slice
+	// is a snapshot.
+	subs := a.subs
+	a.mu.Unlock()
+
+	if from == level || len(subs) == 0 {
+		return
+	}
+
+	c := LevelChange{From: from, To: level, By: by, Time: time.Now()}
+	for _, s := range subs {
+		s.f(c)
+	}
+}
+
+// Subscribe registers f to be called with every change of the level, in
+// the order of registration, and returns a function which unregisters it.
+func (a *AtomicLevel) Subscribe(f func(LevelChange)) (cancel func()) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	id := a.next
+	a.next++
+	a.subs = append(a.subs, subscriber{id: id, f: f})
+
+	return func() {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		for i, s := range a.subs {
+			if s.id == id {
+				a.subs = append(a.subs[:i:i], a.subs[i+1:]...)
+				return
+			}
+		}
+	}
+}
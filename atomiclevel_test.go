@@ -0,0 +1,87 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package logif
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_AtomicLevel(t *testing.T) {
+	a := NewAtomicLevel(WARN)
+
+	if a.Level() != WARN {
+		t.Errorf("Level() = %v, want WARN", a.Level())
+	}
+	if a.Enabled(INFO) || !a.Enabled(WARN) || !a.Enabled(ERROR) {
+		t.Errorf("Enabled does not follow WARN")
+	}
+
+	a.SetLevel(OFF)
+	if a.Enabled(ERROR) || a.Enabled(OFF) {
+		t.Errorf("Enabled at OFF")
+	}
+}
+
+func Test_AtomicLevel_clamp(t *testing.T) {
+	if got := NewAtomicLevel(1).Level(); got != INFO {
+		t.Errorf("NewAtomicLevel(1).Level() = %v, want INFO", got)
+	}
+
+	a := NewAtomicLevel(WARN)
+	a.SetLevel(ERROR + 1)
+	if got := a.Level(); got != OFF {
+		t.Errorf("Level() = %v, want OFF", got)
+	}
+	a.SetLevelBy(-5, "test")
+	if got := a.Level(); got != DEBUG {
+		t.Errorf("Level() = %v, want DEBUG", got)
+	}
+}
+
+func Test_AtomicLevel_Subscribe(t *testing.T) {
+	a := NewAtomicLevel(WARN)
+
+	var changes []LevelChange
+	cancel := a.Subscribe(func(c LevelChange) {
+		changes = append(changes, c)
+	})
+
+	a.SetLevel(DEBUG)
+	a.SetLevel(DEBUG)
+	a.SetLevelBy(ERROR, "test")
+
+	if len(changes) != 2 {
+		t.Fatalf("got %d changes, want 2", len(changes))
+	}
+	if c := changes[0]; c.From != WARN || c.To != DEBUG || !strings.Contains(c.By, "atomiclevel_test.go:") || c.Time.IsZero() {
+		t.Errorf("change = %+v", c)
+	}
+	if c := changes[1]; c.From != DEBUG || c.To != ERROR || c.By != "test" {
+		t.Errorf("change = %+v", c)
+	}
+
+	cancel()
+	a.SetLevel(INFO)
+	if len(changes) != 2 {
+		t.Errorf("got %d changes after cancel, want 2", len(changes))
+	}
+}
+
+func Test_AtomicLevel_Subscribe_reentrant(t *testing.T) {
+	a := NewAtomicLevel(WARN)
+
+	// a subscriber reading and changing the level does not deadlock.
+	a.Subscribe(func(c LevelChange) {
+		if a.Level() == DEBUG {
+			a.SetLevelBy(INFO, "subscriber")
+		}
+	})
+
+	a.SetLevel(DEBUG)
+	if got := a.Level(); got != INFO {
+		t.Errorf("Level() = %v, want INFO", got)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import "sync"
+
+// maxPooledBuffer is the largest buffer capacity returned to the pool.
+// Records above it are rare and should not pin memory.
+const maxPooledBuffer = 64 << 10
+
+// buffer holds one log line while it is being formatted.
+type buffer struct {
+	b   []byte
+	msg int // offset of the message (the text after the header)
+}
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return &buffer{b: make([]byte, 0, 256)}
+	},
+}
+
+func getBuffer() *buffer {
+	return bufferPool.Get().(*buffer)
+}
+
+func putBuffer(b *buffer) {
+	if cap(b.b) > maxPooledBuffer {
+		return
+	}
+
+	b.b = b.b[:0]
+	b.msg = 0
+	bufferPool.Put(b)
+}
+
+// Write implements io.Writer so that fmt can format directly into b.
+func (b *buffer) Write(p []byte) (int, error) {
+	b.b = append(b.b, p...)
+	return len(p), nil
+}
+
+// WriteString appends s to b.
+func (b *buffer) WriteString(s string) (int, error) {
+	b.b = append(b.b, s...)
+	return len(s), nil
+}
+
+// WriteByte appends c to b.
+func (b *buffer) WriteByte(c byte) error {
+	b.b = append(b.b, c)
+	return nil
+}
+
+// message returns the text written after the header.
+func (b *buffer) message() []byte {
+	return b.b[b.msg:]
+}
+
+// appendInt appends the decimal i, zero padded to wid digits.
+// A negative wid avoids padding.
+func (b *buffer) appendInt(i int, wid int) {
+	// Assemble decimal in reverse order.
+	var d [20]byte
+	bp := len(d) - 1
+	for i >= 10 || wid > 1 {
+		wid--
+		q := i / 10
+		d[bp] = byte('0' + i - q*10)
+		bp--
+		i = q
+	}
+	// i < 10
+	d[bp] = byte('0' + i)
+	b.b = append(b.b, d[bp:]...)
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	helpersMu sync.Mutex   // serializes updates of helpers
+	helpers   atomic.Value // map[string]struct{}; names of helper functions
+)
+
+// Helper marks the calling function as a logging helper. When the file and
+// line number are printed, helper functions are skipped so that they point
+// to the caller of the helper, as testing.T.Helper does:
+//
+//	func check(err error) {
+//		gologif.Helper()
+//		if err != nil {
+//			l.Error(err)
+//		}
+//	}
+//
+// Helper applies to every logger. It may be called from multiple goroutines.
+func Helper() {
+	var pcs [1]uintptr
+	if runtime.Callers(2, pcs[:]) == 0 {
+		return
+	}
+	f, _ := runtime.CallersFrames(pcs[:]).Next()
+
+	h, _ := helpers.Load().(map[string]struct{})
+	if _, ok := h[f.Function]; ok {
+		return
+	}
+
+	helpersMu.Lock()
+	defer helpersMu.Unlock()
+
+	h, _ = helpers.Load().(map[string]struct{})
+	m := make(map[string]struct{}, len(h)+1)
+	for k := range h {
+		m[k] = struct{}{}
+	}
+	m[f.Function] = struct{}{}
+	helpers.Store(m)
+}
+
+// caller returns the file and line number of the caller skip frames above
+// the caller of caller, as runtime.Caller does, skipping helper functions.
+func caller(skip int) (file string, line int) {
+	if h, _ := helpers.Load().(map[string]struct{}); len(h) != 0 {
+		return callerOutside(skip + 1)
+	}
+
+	_, file, line, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return "???", 0
+	}
+
+	return file, line
+}
+
+// callerName returns the file and line number of the caller skip frames
+// above the caller of callerName as "file:line".
+func callerName(skip int) string {
+	file, line := caller(skip + 1)
+	return file + ":" + strconv.Itoa(line)
+}
+
+// callerOutside returns the file and line number of the first frame,
+// starting skip frames above the caller of callerOutside, whose function is
+// neither in one of pkgs nor a helper function.
+//
+// It lets adapters called through other packages, such as the standard
+// "log" package, report the call site in the user code.
+func callerOutside(skip int, pkgs ...string) (file string, line int) {
+	var pcs [32]uintptr
+	n := runtime.Callers(skip+2, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	h, _ := helpers.Load().(map[string]struct{})
+
+	for {
+		f, more := frames.Next()
+		if _, helper := h[f.Function]; !helper && !inPackages(f.Function, pkgs) {
+			return f.File, f.Line
+		}
+		if !more {
+			return "???", 0
+		}
+	}
+}
+
+// inPackages reports whether the function named fn, as reported by
+// runtime.Frame, is in one of pkgs.
+func inPackages(fn string, pkgs []string) bool {
+	pkg := fn
+	if i := strings.LastIndexByte(fn, '/'); i >= 0 {
+		if j := strings.IndexByte(fn[i:], '.'); j >= 0 {
+			pkg = fn[:i+j]
+		}
+	} else if j := strings.IndexByte(fn, '.'); j >= 0 {
+		pkg = fn[:j]
+	}
+
+	for _, p := range pkgs {
+		if pkg == p {
+			return true
+		}
+	}
+
+	return false
+}
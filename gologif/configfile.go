@@ -0,0 +1,554 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shimt/go-logif"
+)
+
+// Config is a logging setup read from a JSON file by a Manager:
+//
+//	{
+//		"loggers": {
+//			"":   {"level": "INFO", "flags": "date,time,shortfile", "sinks": ["stderr", "app"]},
+//			"db": {"level": "DEBUG", "prefix": "db: "}
+//		},
+//		"sinks": {
+//			"app": {"type": "file", "path": "/var/log/app.log", "max_size": 10485760, "max_backups": 5}
+//		}
+//	}
+//
+// The logger named "" is the root logger. The other loggers take the
+// settings they do not set from the root logger.
+type Config struct {
+	Loggers map[string]LoggerConfig `json:"loggers"`
+	Sinks   map[string]SinkConfig   `json:"sinks"`
+}
+
+// LoggerConfig is the configuration of a logger. A nil field is not set.
+type LoggerConfig struct {
+	// Level is the output level, WARN by default.
+	Level *logif.LogLevel `json:"level,omitempty"`
+	// Prefix is the output prefix. When not set, the prefix of the logger,
+	// or the function set by SetPrefixFunc, is left as it is.
+	Prefix *string `json:"prefix,omitempty"`
+	// Flags are the names of the output flags separated by commas, such
+	// as "date,time,shortfile"; see ParseFlags. LstdFlags by default.
+	Flags *string `json:"flags,omitempty"`
+	// LevelFormat is the layout of the level tag, such as
+	// {"style": "bare", "width": 5}.
+	LevelFormat *LevelFormat `json:"level_format,omitempty"`
+	// TimeLayout is the layout of the time; see SetTimeLayout.
+	TimeLayout *string `json:"time_layout,omitempty"`
+	// Location is the name of the location of the time, such as "UTC".
+	Location *string `json:"location,omitempty"`
+	// Sinks are the names of the sinks the logger writes to, ["stderr"]
+	// by default.
+	Sinks []string `json:"sinks,omitempty"`
+}
+
+// Sink types of SinkConfig. The sinks "stderr", "stdout" and "discard" of
+// the same types are always defined.
+const (
+	SinkStderr  = "stderr"
+	SinkStdout  = "stdout"
+	SinkDiscard = "discard"
+	SinkFile    = "file"
+)
+
+// SinkConfig is the configuration of a destination of loggers.
+type SinkConfig struct {
+	// Type is one of SinkStderr, SinkStdout, SinkDiscard and SinkFile.
+	Type string `json:"type"`
+	// Path is the path of the file of SinkFile.
+	Path string `json:"path,omitempty"`
+	// MaxSize is the size in bytes at which the file is rotated, or 0.
+	MaxSize int64 `json:"max_size,omitempty"`
+	// MaxBackups is the number of rotated files kept.
+	MaxBackups int `json:"max_backups,omitempty"`
+}
+
+// ParseConfig parses and validates a Config in JSON.
+func ParseConfig(data []byte) (*Config, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+
+	c := &Config{}
+	if err := d.Decode(c); err != nil {
+		return nil, fmt.Errorf("gologif: config: %v", err)
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Config) validate() error {
+	for name, s := range c.Sinks {
+		switch s.Type {
+		case SinkStderr, SinkStdout, SinkDiscard:
+		case SinkFile:
+			if s.Path == "" {
+				return fmt.Errorf("gologif: config: sink %q: no path", name)
+			}
+		default:
+			return fmt.Errorf("gologif: config: sink %q: unknown type %q", name, s.Type)
+		}
+	}
+
+	for _, name := range sortedKeys(c.Loggers) {
+		if _, err := c.resolve(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sink returns the configuration of the sink named name.
+func (c *Config) sink(name string) (SinkConfig, bool) {
+	if s, ok := c.Sinks[name]; ok {
+		return s, true
+	}
+
+	switch name {
+	case SinkStderr, SinkStdout, SinkDiscard:
+		return SinkConfig{Type: name}, true
+	}
+
+	return SinkConfig{}, false
+}
+
+// settings are the resolved settings of a logger.
+type settings struct {
+	level       logif.LogLevel
+	prefix      *string // nil if not set
+	flags       int
+	levelFormat LevelFormat
+	timeLayout  string
+	location    *time.Location
+	sinks       []string
+}
+
+// resolve returns the settings of the logger named name, taking the
+// settings it does not set from the root logger and the defaults.
+func (c *Config) resolve(name string) (*settings, error) {
+	s := &settings{
+		level: logif.WARN,
+		flags: LstdFlags,
+		sinks: []string{SinkStderr},
+	}
+
+	lcs := []LoggerConfig{c.Loggers[""]}
+	if name != "" {
+		lcs = append(lcs, c.Loggers[name])
+	}
+
+	for _, lc := range lcs {
+		if lc.Level != nil {
+			s.level = *lc.Level
+		}
+		if lc.Prefix != nil {
+			s.prefix = lc.Prefix
+		}
+		if lc.Flags != nil {
+			f, err := ParseFlags(*lc.Flags)
+			if err != nil {
+				return nil, fmt.Errorf("gologif: config: logger %q: %v", name, err)
+			}
+			s.flags = f
+		}
+		if lc.LevelFormat != nil {
+			s.levelFormat = *lc.LevelFormat
+		}
+		if lc.TimeLayout != nil {
+			s.timeLayout = *lc.TimeLayout
+		}
+		if lc.Location != nil {
+			loc, err := time.LoadLocation(*lc.Location)
+			if err != nil {
+				return nil, fmt.Errorf("gologif: config: logger %q: %v", name, err)
+			}
+			s.location = loc
+		}
+		if lc.Sinks != nil {
+			s.sinks = lc.Sinks
+		}
+	}
+
+	for _, sink := range s.sinks {
+		if _, ok := c.sink(sink); !ok {
+			return nil, fmt.Errorf("gologif: config: logger %q: unknown sink %q", name, sink)
+		}
+	}
+
+	return s, nil
+}
+
+var flagNames = []struct {
+	name string
+	flag int
+}{
+	{"date", Ldate},
+	{"time", Ltime},
+	{"microseconds", Lmicroseconds},
+	{"longfile", Llongfile},
+	{"shortfile", Lshortfile},
+	{"utc", LUTC},
+	{"msgprefix", msgprefix},
+	{"stdflags", LstdFlags},
+}
+
+// ParseFlags returns the output flags named in s, separated by commas,
+// case-insensitive: "date", "time", "microseconds", "longfile",
+// "shortfile", "utc", "msgprefix" and "stdflags". An empty s is 0.
+func ParseFlags(s string) (int, error) {
+	flag := 0
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		found := false
+		for _, f := range flagNames {
+			if strings.EqualFold(name, f.name) {
+				flag |= f.flag
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("gologif: unknown flag %q", name)
+		}
+	}
+
+	return flag, nil
+}
+
+// sink is an open destination of loggers.
+type sink struct {
+	config SinkConfig
+	w      io.Writer
+	closer io.Closer // set when the sink opened w itself
+}
+
+func openSink(c SinkConfig) (*sink, error) {
+	s := &sink{config: c}
+
+	switch c.Type {
+	case SinkStderr:
+		s.w = os.Stderr
+	case SinkStdout:
+		s.w = os.Stdout
+	case SinkDiscard:
+		s.w = ioutil.Discard
+	case SinkFile:
+		f, err := OpenRotatingFile(c.Path, c.MaxSize, c.MaxBackups)
+		if err != nil {
+			return nil, fmt.Errorf("gologif: config: %v", err)
+		}
+		s.w, s.closer = f, f
+	}
+
+	return s, nil
+}
+
+// close closes the file opened by the sink. The standard error and output
+// of the process are left open.
+func (s *sink) close() error {
+	if s.closer != nil {
+		return s.closer.Close()
+	}
+	return nil
+}
+
+// Manager builds the loggers described by a JSON configuration file, and
+// applies the changes of the file to them at runtime.
+//
+// It may be used simultaneously from multiple goroutines.
+type Manager struct {
+	path string
+
+	mu      sync.Mutex // serializes Reload; protects the fields below
+	config  *Config
+	loggers map[string]*Logger
+	sinks   map[string]*sink
+	modTime time.Time
+	size    int64
+	closed  bool
+}
+
+var errManagerClosed = errors.New("gologif: config: manager closed")
+
+// NewManager reads the configuration file at path and builds its loggers.
+func NewManager(path string) (*Manager, error) {
+	m := &Manager{
+		path:    path,
+		loggers: make(map[string]*Logger),
+		sinks:   make(map[string]*sink),
+	}
+	if err := m.Reload(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Logger returns the logger named name, "" for the root logger. A logger
+// not in the configuration has the settings of the root logger, and gets
+// its own settings if a later configuration adds it.
+//
+// After Close, a new logger discards its output.
+func (m *Manager) Logger(name string) *Logger {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if l, ok := m.loggers[name]; ok {
+		return l
+	}
+	if m.closed {
+		return New(ioutil.Discard, "", 0)
+	}
+
+	// the names of the configuration were validated by Reload.
+	s, _ := m.config.resolve(name)
+	l := New(ioutil.Discard, "", 0)
+	m.apply(l, s, m.sinks)
+	m.loggers[name] = l
+
+	return l
+}
+
+// Reload reads the configuration file again and applies it to the loggers.
+//
+// An invalid configuration is rejected with an error, and the previous
+// configuration stays active. Lines are not lost: each logger switches to
+// its new sinks between two lines, and the sinks no longer used are closed
+// afterwards.
+func (m *Manager) Reload() error {
+	// the file is read under the lock, so that a concurrent Reload does not
+	// apply an older read after a newer one.
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return errManagerClosed
+	}
+
+	fi, err := os.Stat(m.path)
+	if err != nil {
+		return fmt.Errorf("gologif: config: %v", err)
+	}
+	data, err := ioutil.ReadFile(m.path)
+	if err != nil {
+		return fmt.Errorf("gologif: config: %v", err)
+	}
+	c, err := ParseConfig(data)
+	if err != nil {
+		return fmt.Errorf("%v (%s)", err, m.path)
+	}
+
+	names := make(map[string]bool, len(c.Loggers)+len(m.loggers))
+	for name := range c.Loggers {
+		names[name] = true
+	}
+	for name := range m.loggers {
+		names[name] = true
+	}
+
+	all := make(map[string]*settings, len(names))
+	for name := range names {
+		if all[name], err = c.resolve(name); err != nil {
+			return err
+		}
+	}
+
+	sinks, err := m.openSinks(c, all)
+	if err != nil {
+		return err
+	}
+
+	for name, s := range all {
+		l, ok := m.loggers[name]
+		if !ok {
+			l = New(ioutil.Discard, "", 0)
+			m.loggers[name] = l
+		}
+		m.apply(l, s, sinks)
+	}
+
+	for name, s := range m.sinks {
+		if sinks[name] != s {
+			s.close()
+		}
+	}
+
+	m.config, m.sinks = c, sinks
+	m.modTime, m.size = fi.ModTime(), fi.Size()
+
+	return nil
+}
+
+// openSinks returns the sinks used by the loggers, reusing the current
+// sinks whose configuration is unchanged. On error, the sinks opened are
+// closed again.
+func (m *Manager) openSinks(c *Config, all map[string]*settings) (map[string]*sink, error) {
+	sinks := make(map[string]*sink)
+
+	for _, s := range all {
+		for _, name := range s.sinks {
+			if _, ok := sinks[name]; ok {
+				continue
+			}
+
+			sc, _ := c.sink(name)
+			if old, ok := m.sinks[name]; ok && old.config == sc {
+				sinks[name] = old
+				continue
+			}
+
+			sk, err := openSink(sc)
+			if err != nil {
+				for name, s := range sinks {
+					if m.sinks[name] != s {
+						s.close()
+					}
+				}
+				return nil, err
+			}
+			sinks[name] = sk
+		}
+	}
+
+	return sinks, nil
+}
+
+// apply sets the settings s to l, writing to the sinks. The formatting
+// settings are changed at once, so that no line mixes old and new ones.
+func (m *Manager) apply(l *Logger, s *settings, sinks map[string]*sink) {
+	ws := make([]io.Writer, 0, len(s.sinks))
+	for _, name := range s.sinks {
+		ws = append(ws, sinks[name].w)
+	}
+
+	var w io.Writer
+	if len(ws) == 1 {
+		w = ws[0]
+	} else {
+		w = io.MultiWriter(ws...)
+	}
+
+	l.update(func(c *config) {
+		c.flag = s.flags
+		if s.prefix != nil {
+			c.prefix, c.prefixFunc = *s.prefix, nil
+		}
+		c.levelFormat = s.levelFormat
+		c.tags = newLevelTags(&s.levelFormat)
+		c.timeLayout = s.timeLayout
+		c.location = s.location
+	})
+	l.SetOutput(w)
+	l.setOutputLevel(s.level, "config "+m.path)
+}
+
+// Watch polls the configuration file every interval, and reloads it when
+// its modification time or size changes. Errors are passed to report,
+// which may be nil; an error is reported once until it changes.
+//
+// It returns a function which stops the polling.
+func (m *Manager) Watch(interval time.Duration, report func(error)) (stop func()) {
+	done := make(chan struct{})
+	t := time.NewTicker(interval)
+
+	go func() {
+		defer t.Stop()
+
+		var last string
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+			}
+
+			err := m.reloadIfChanged()
+			switch {
+			case err == nil:
+				last = ""
+			case err.Error() != last:
+				last = err.Error()
+				if report != nil {
+					report(err)
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+func (m *Manager) reloadIfChanged() error {
+	fi, err := os.Stat(m.path)
+	if err != nil {
+		return fmt.Errorf("gologif: config: %v", err)
+	}
+
+	m.mu.Lock()
+	changed := !fi.ModTime().Equal(m.modTime) || fi.Size() != m.size
+	m.mu.Unlock()
+
+	if !changed {
+		return nil
+	}
+
+	return m.Reload()
+}
+
+// Close closes the files of the loggers. The loggers must not be used
+// afterwards, and Reload fails.
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.closed = true
+
+	var err error
+	for _, s := range m.sinks {
+		if cerr := s.close(); err == nil {
+			err = cerr
+		}
+	}
+	m.sinks = map[string]*sink{}
+
+	return err
+}
+
+func sortedKeys(m map[string]LoggerConfig) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,300 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_ParseFlags(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    int
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"date,time", Ldate | Ltime, false},
+		{" StdFlags , shortfile", LstdFlags | Lshortfile, false},
+		{"date,nope", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseFlags(tt.s)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseFlags(%q) = %v, %v, want %v", tt.s, got, err, tt.want)
+		}
+	}
+}
+
+func Test_ParseConfig_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"syntax", `{"loggers": `, "unexpected EOF"},
+		{"field", `{"logger": {}}`, `unknown field "logger"`},
+		{"level", `{"loggers": {"": {"level": "LOUD"}}}`, `unknown log level "LOUD"`},
+		{"flags", `{"loggers": {"db": {"flags": "date,nope"}}}`, `logger "db": gologif: unknown flag "nope"`},
+		{"sink", `{"loggers": {"": {"sinks": ["app"]}}}`, `logger "": unknown sink "app"`},
+		{"sink type", `{"sinks": {"app": {"type": "syslog"}}}`, `sink "app": unknown type "syslog"`},
+		{"path", `{"sinks": {"app": {"type": "file"}}}`, `sink "app": no path`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfig([]byte(tt.json))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ParseConfig = %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Manager(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gologif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := filepath.Join(dir, "log.json")
+	app := filepath.Join(dir, "app.log")
+	db := filepath.Join(dir, "db.log")
+	write := func(s string) {
+		s = strings.NewReplacer("APP", app, "DB", db).Replace(s)
+		if err := ioutil.WriteFile(config, []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	read := func(path string) string {
+		b, _ := ioutil.ReadFile(path)
+		return string(b)
+	}
+
+	write(`{
+		"loggers": {
+			"":   {"level": "WARN", "flags": "", "sinks": ["app"]},
+			"db": {"level": "DEBUG", "prefix": "db: ", "level_format": {"style": "bare"}}
+		},
+		"sinks": {"app": {"type": "file", "path": "APP"}}
+	}`)
+	m, err := NewManager(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	root, dbl, other := m.Logger(""), m.Logger("db"), m.Logger("other")
+	root.Info("suppressed")
+	root.Warn("root")
+	dbl.Debug("query")
+	other.Error("other")
+
+	want := "[WARN] root\ndb: DEBUG query\n[ERROR] other\n"
+	if got := read(app); got != want {
+		t.Errorf("app.log = %q, want %q", got, want)
+	}
+
+	// the levels and sinks are changed at runtime.
+	write(`{
+		"loggers": {
+			"":      {"level": "INFO", "flags": "", "sinks": ["app"]},
+			"other": {"sinks": ["db"]}
+		},
+		"sinks": {
+			"app": {"type": "file", "path": "APP"},
+			"db":  {"type": "file", "path": "DB"}
+		}
+	}`)
+	if err := m.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	root.Info("info")
+	dbl.Debug("suppressed")
+	other.Info("moved")
+
+	if got, want := read(app), want+"[INFO] info\n"; got != want {
+		t.Errorf("app.log = %q, want %q", got, want)
+	}
+	if got, want := read(db), "[INFO] moved\n"; got != want {
+		t.Errorf("db.log = %q, want %q", got, want)
+	}
+
+	// an invalid configuration keeps the previous one.
+	write(`{"loggers": {"": {"level": "LOUD"}}}`)
+	if err := m.Reload(); err == nil || !strings.Contains(err.Error(), config) {
+		t.Errorf("Reload = %v, want an error naming the file", err)
+	}
+	if root.OutputLevel() != INFO {
+		t.Errorf("OutputLevel = %v after an invalid configuration", root.OutputLevel())
+	}
+}
+
+func Test_Manager_Watch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gologif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := filepath.Join(dir, "log.json")
+	if err := ioutil.WriteFile(config, []byte(`{"loggers": {"": {"sinks": ["discard"]}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewManager(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	errs := make(chan error, 1)
+	stop := m.Watch(10*time.Millisecond, func(err error) { errs <- err })
+	defer stop()
+
+	if err := ioutil.WriteFile(config, []byte(`{"loggers": {"": {"level": "ERROR", "sinks": ["discard"]}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for deadline := time.Now().Add(5 * time.Second); m.Logger("").OutputLevel() != ERROR; {
+		if time.Now().After(deadline) {
+			t.Fatalf("configuration not reloaded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := ioutil.WriteFile(config, []byte(`{`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errs:
+		if !strings.Contains(err.Error(), "gologif: config:") {
+			t.Errorf("reported %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("invalid configuration not reported")
+	}
+}
+
+func Test_Manager_stderr(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gologif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	stderr, err := os.Create(filepath.Join(dir, "stderr"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stderr.Close()
+	defer func(f *os.File) { os.Stderr = f }(os.Stderr)
+	os.Stderr = stderr
+
+	config := filepath.Join(dir, "log.json")
+	if err := ioutil.WriteFile(config, []byte(`{"loggers": {"": {"sinks": ["stderr"]}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewManager(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the standard error is neither closed when no longer used, nor by Close.
+	s := `{"loggers": {"": {"sinks": ["app"]}}, "sinks": {"app": {"type": "file", "path": "` +
+		filepath.ToSlash(filepath.Join(dir, "app.log")) + `"}}}`
+	if err := ioutil.WriteFile(config, []byte(s), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stderr.WriteString("after reload\n"); err != nil {
+		t.Errorf("write to stderr after Reload: %v", err)
+	}
+
+	if err := ioutil.WriteFile(config, []byte(`{"loggers": {"": {"sinks": ["stderr"]}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stderr.WriteString("after close\n"); err != nil {
+		t.Errorf("write to stderr after Close: %v", err)
+	}
+}
+
+func Test_Manager_Close(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gologif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := filepath.Join(dir, "log.json")
+	if err := ioutil.WriteFile(config, []byte(`{"loggers": {"": {"sinks": ["discard"]}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewManager(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Close()
+
+	// a new logger after Close discards its output instead of panicking.
+	m.Logger("new").Error("discarded")
+
+	if err := m.Reload(); err == nil {
+		t.Errorf("Reload after Close succeeded")
+	}
+}
+
+func Test_Manager_prefixFunc(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gologif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := filepath.Join(dir, "log.json")
+	write := func(s string) {
+		if err := ioutil.WriteFile(config, []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(`{"loggers": {"": {"flags": "", "sinks": ["discard"]}}}`)
+	m, err := NewManager(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	l := m.Logger("")
+	l.SetPrefixFunc(func() string { return "func: " })
+
+	// a reload without a prefix keeps the prefix function of the application.
+	write(`{"loggers": {"": {"level": "INFO", "flags": "", "sinks": ["discard"]}}}`)
+	if err := m.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if l.config().prefixFunc == nil {
+		t.Errorf("prefix function cleared by a configuration without a prefix")
+	}
+
+	write(`{"loggers": {"": {"prefix": "conf: ", "flags": "", "sinks": ["discard"]}}}`)
+	if err := m.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if l.config().prefixFunc != nil || l.Prefix() != "conf: " {
+		t.Errorf("prefix = %q, want the prefix of the configuration", l.Prefix())
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"fmt"
+
+	"github.com/shimt/go-logif"
+)
+
+// WithCallerSkip returns a new logger writing to the same output as l with
+// the current settings of l, which skips n more stack frames when the file
+// and line number are printed. It shares the output level of l, so that a
+// later change of either is seen by both.
+//
+// It is meant for a logger used only through wrapper functions, so that the
+// file and line number point to the caller of the wrapper.
+func (l *Logger) WithCallerSkip(n int) *Logger {
+	d := l.derive()
+	d.update(func(c *config) {
+		c.callerSkip += n
+	})
+
+	return d
+}
+
+func (l *Logger) ldp(depth int, level logif.LogLevel, v []interface{}) {
+	b := l.header(3+depth, level)
+	fmt.Fprint(b, logif.ResolveLazy(v)...)
+	l.lwrite(level, b)
+}
+
+// LogDepth write message(level=level) to the logger, skipping depth stack
+// frames above the caller when the file and line number are printed.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Logger) LogDepth(depth int, level logif.LogLevel, v ...interface{}) {
+	if !l.wants(level) {
+		return
+	}
+
+	l.ldp(depth, level, v)
+}
+
+// DebugDepth write message(level=DEBUG) to the logger, skipping depth stack
+// frames above the caller when the file and line number are printed.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Logger) DebugDepth(depth int, v ...interface{}) {
+	if !l.wants(logif.DEBUG) {
+		return
+	}
+
+	l.ldp(depth, logif.DEBUG, v)
+}
+
+// InfoDepth write message(level=INFO) to the logger, skipping depth stack
+// frames above the caller when the file and line number are printed.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Logger) InfoDepth(depth int, v ...interface{}) {
+	if !l.wants(logif.INFO) {
+		return
+	}
+
+	l.ldp(depth, logif.INFO, v)
+}
+
+// WarnDepth write message(level=WARN) to the logger, skipping depth stack
+// frames above the caller when the file and line number are printed.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Logger) WarnDepth(depth int, v ...interface{}) {
+	if !l.wants(logif.WARN) {
+		return
+	}
+
+	l.ldp(depth, logif.WARN, v)
+}
+
+// ErrorDepth write message(level=ERROR) to the logger, skipping depth stack
+// frames above the caller when the file and line number are printed.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Logger) ErrorDepth(depth int, v ...interface{}) {
+	if !l.wants(logif.ERROR) {
+		return
+	}
+
+	l.ldp(depth, logif.ERROR, v)
+}
+
+// LogDepth write message(level=level) to the standard logger.
+// See Logger.LogDepth.
+func LogDepth(depth int, level logif.LogLevel, v ...interface{}) {
+	if !std.wants(level) {
+		return
+	}
+
+	std.ldp(depth, level, v)
+}
+
+// DebugDepth write message(level=DEBUG) to the standard logger.
+// See Logger.DebugDepth.
+func DebugDepth(depth int, v ...interface{}) {
+	if !std.wants(logif.DEBUG) {
+		return
+	}
+
+	std.ldp(depth, logif.DEBUG, v)
+}
+
+// InfoDepth write message(level=INFO) to the standard logger.
+// See Logger.InfoDepth.
+func InfoDepth(depth int, v ...interface{}) {
+	if !std.wants(logif.INFO) {
+		return
+	}
+
+	std.ldp(depth, logif.INFO, v)
+}
+
+// WarnDepth write message(level=WARN) to the standard logger.
+// See Logger.WarnDepth.
+func WarnDepth(depth int, v ...interface{}) {
+	if !std.wants(logif.WARN) {
+		return
+	}
+
+	std.ldp(depth, logif.WARN, v)
+}
+
+// ErrorDepth write message(level=ERROR) to the standard logger.
+// See Logger.ErrorDepth.
+func ErrorDepth(depth int, v ...interface{}) {
+	if !std.wants(logif.ERROR) {
+		return
+	}
+
+	std.ldp(depth, logif.ERROR, v)
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"testing"
+)
+
+func wrapDepth(l *Logger, msg string) {
+	l.ErrorDepth(1, msg)
+}
+
+func wrapSkip(l *Logger, msg string) {
+	l.Error(msg)
+}
+
+func wrapHelper(l *Logger, msg string) {
+	Helper()
+	l.Error(msg)
+}
+
+func Test_Logger_callerDepth(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(l *Logger)
+		want string
+	}{
+		{"depth", func(l *Logger) { wrapDepth(l, "message") }, "depth_test.go:31: [ERROR] message\n"},
+		{"log", func(l *Logger) { l.LogDepth(0, ERROR, "message") }, "depth_test.go:32: [ERROR] message\n"},
+		{"skip", func(l *Logger) { wrapSkip(l.WithCallerSkip(1), "message") }, "depth_test.go:33: [ERROR] message\n"},
+		{"helper", func(l *Logger) { wrapHelper(l, "message") }, "depth_test.go:34: [ERROR] message\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			tt.f(New(b, "", Lshortfile))
+
+			if got := b.String(); got != tt.want {
+				t.Errorf("got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
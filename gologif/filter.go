@@ -0,0 +1,59 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+// Filter rewrites the message of a record before it reaches the writer.
+//
+// The message is the text after the header and the level tag.
+// Filter may modify msg in place and return it, or return a new slice.
+// It is called concurrently and must not retain msg.
+type Filter interface {
+	Filter(msg []byte) []byte
+}
+
+// FilterFunc is an adapter to allow the use of ordinary functions as Filter.
+type FilterFunc func(msg []byte) []byte
+
+// Filter calls f(msg).
+func (f FilterFunc) Filter(msg []byte) []byte {
+	return f(msg)
+}
+
+// SetFilters sets the filters applied in order to every message.
+// Calling SetFilters with no arguments removes the filters.
+func (l *Logger) SetFilters(filters ...Filter) {
+	filters = append([]Filter(nil), filters...)
+	l.update(func(c *config) { c.filters = filters })
+}
+
+// Filters returns the filters of the logger.
+func (l *Logger) Filters() []Filter {
+	return append([]Filter(nil), l.config().filters...)
+}
+
+// filter runs the filters of the logger over the message in b.
+func (l *Logger) filter(b *buffer) {
+	filters := l.config().filters
+	if len(filters) == 0 {
+		return
+	}
+
+	m := b.message()
+	for _, f := range filters {
+		m = f.Filter(m)
+	}
+
+	b.b = append(b.b[:b.msg], m...)
+}
+
+// SetFilters sets the filters of the standard logger.
+func SetFilters(filters ...Filter) {
+	std.SetFilters(filters...)
+}
+
+// Filters returns the filters of the standard logger.
+func Filters() []Filter {
+	return std.Filters()
+}
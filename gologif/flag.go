@@ -0,0 +1,175 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"encoding"
+	"flag"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/shimt/go-logif"
+)
+
+// LogFlags are the values of the command-line flags registered by
+// RegisterFlags.
+type LogFlags struct {
+	Level     logif.LogLevel
+	Format    LevelStyle
+	File      string
+	Flags     int
+	Verbosity int
+	VModule   string
+}
+
+// RegisterFlags registers the flags -log-level, -log-format, -log-file,
+// -log-flags, -log-v and -log-vmodule on fs, flag.CommandLine if fs is nil.
+// After fs is parsed, the returned LogFlags configure a logger:
+//
+//	lf := gologif.RegisterFlags(nil)
+//	flag.Parse()
+//	l, closer, err := lf.New()
+//	if err != nil {
+//		...
+//	}
+//	defer closer.Close()
+func RegisterFlags(fs *flag.FlagSet) *LogFlags {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	f := &LogFlags{
+		Level: logif.WARN,
+		Flags: LstdFlags,
+	}
+
+	names := make([]string, 0, len(logif.Levels())+1)
+	for _, l := range logif.Levels() {
+		names = append(names, l.String())
+	}
+	names = append(names, logif.OFF.String())
+
+	fs.Var(textValue{&f.Level}, "log-level", "output level: "+strings.Join(names, ", "))
+	fs.Var(textValue{&f.Format}, "log-format", "level tag style: "+strings.Join(levelStyleNames, ", "))
+	fs.StringVar(&f.File, "log-file", "", "append the log to `file` instead of standard error")
+	fs.Var((*flagsValue)(&f.Flags), "log-flags", "output flags separated by commas: "+flagNameList())
+	fs.IntVar(&f.Verbosity, "log-v", 0, "verbosity of V logging")
+	fs.StringVar(&f.VModule, "log-vmodule", "", "verbosity of source files: comma-separated `pattern=N` list")
+
+	return f
+}
+
+// New creates a new logger configured by the flags. The returned io.Closer
+// closes the file of -log-file, if any.
+func (f *LogFlags) New() (*Logger, io.Closer, error) {
+	l := New(os.Stderr, "", 0)
+	closer, err := f.Apply(l)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return l, closer, nil
+}
+
+// Apply configures l with the flags. The file of -log-file, if any, is
+// opened for appending, and is closed by the returned io.Closer; the
+// io.Closer does nothing when there is no file.
+func (f *LogFlags) Apply(l *Logger) (io.Closer, error) {
+	if err := l.SetVModule(f.VModule); err != nil {
+		return nil, err
+	}
+
+	var closer io.Closer = nopCloser{}
+	if f.File != "" {
+		w, err := os.OpenFile(f.File, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, err
+		}
+		l.SetOutput(w)
+		closer = w
+	}
+
+	l.SetFlags(f.Flags)
+	lf := l.LevelFormat()
+	lf.Style = f.Format
+	l.SetLevelFormat(lf)
+	l.SetVerbosity(f.Verbosity)
+	l.setOutputLevel(f.Level, "flags")
+
+	return closer, nil
+}
+
+// ApplyStd configures the standard logger with the flags. See Apply.
+func (f *LogFlags) ApplyStd() (io.Closer, error) {
+	return f.Apply(std)
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+// textValue is a flag.Value of a type with text marshaling.
+type textValue struct {
+	p interface {
+		encoding.TextMarshaler
+		encoding.TextUnmarshaler
+	}
+}
+
+func (v textValue) String() string {
+	if v.p == nil {
+		return ""
+	}
+
+	b, _ := v.p.MarshalText()
+	return string(b)
+}
+
+func (v textValue) Set(s string) error {
+	return v.p.UnmarshalText([]byte(s))
+}
+
+// flagsValue is a flag.Value of the output flags, named as in ParseFlags.
+type flagsValue int
+
+func (v *flagsValue) String() string {
+	if v == nil {
+		return ""
+	}
+
+	var names []string
+	rest := int(*v)
+	if rest&LstdFlags == LstdFlags {
+		names = append(names, "stdflags")
+		rest &^= LstdFlags
+	}
+	for _, f := range flagNames {
+		if f.flag != LstdFlags && rest&f.flag != 0 {
+			names = append(names, f.name)
+		}
+	}
+
+	return strings.Join(names, ",")
+}
+
+func (v *flagsValue) Set(s string) error {
+	f, err := ParseFlags(s)
+	if err != nil {
+		return err
+	}
+
+	*v = flagsValue(f)
+	return nil
+}
+
+func flagNameList() string {
+	names := make([]string, len(flagNames))
+	for i, f := range flagNames {
+		names[i] = f.name
+	}
+
+	return strings.Join(names, ", ")
+}
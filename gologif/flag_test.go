@@ -0,0 +1,88 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_RegisterFlags(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gologif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "app.log")
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	lf := RegisterFlags(fs)
+
+	err = fs.Parse([]string{"-log-level=info", "-log-format=letter", "-log-file=" + path, "-log-flags="})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l, closer, err := lf.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closer.Close()
+	l.Debug("suppressed")
+	l.Info("message")
+
+	b, _ := ioutil.ReadFile(path)
+	if got, want := string(b), "I message\n"; got != want {
+		t.Errorf("got = %q, want %q", got, want)
+	}
+
+	if err := closer.Close(); err != nil {
+		t.Errorf("Close = %v", err)
+	}
+}
+
+func Test_LogFlags_Apply_noFile(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	lf := RegisterFlags(fs)
+	if err := fs.Parse([]string{"-log-flags="}); err != nil {
+		t.Fatal(err)
+	}
+
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+	closer, err := lf.Apply(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// without -log-file, the output is kept and Close does nothing.
+	if err := closer.Close(); err != nil {
+		t.Errorf("Close = %v", err)
+	}
+	if l.Warn("message"); b.String() != "[WARN] message\n" {
+		t.Errorf("got = %q", b.String())
+	}
+}
+
+func Test_RegisterFlags_usage(t *testing.T) {
+	b := &bytes.Buffer{}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(b)
+	RegisterFlags(fs)
+
+	if err := fs.Parse([]string{"-log-level=loud"}); err == nil {
+		t.Errorf("Parse of an unknown level succeeded")
+	}
+	for _, want := range []string{"DEBUG, INFO, WARN, ERROR", "OFF", `(default WARN)`, `(default stdflags)`, "bracket, bare, letter"} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("usage does not contain %q:\n%s", want, b.String())
+		}
+	}
+}
@@ -10,7 +10,9 @@ import "io"
 
 // Writer returns the output destination for the logger.
 func (l *Logger) Writer() io.Writer {
-	return l.entity.Writer()
+	l.out.mu.Lock()
+	defer l.out.mu.Unlock()
+	return l.out.w
 }
 
 // Writer returns the output destination for the standard logger.
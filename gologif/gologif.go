@@ -2,7 +2,8 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package gologif is wrapper of a standard "log" package.
+// Package gologif is a leveled logger with the output format and flags of
+// the standard "log" package.
 package gologif
 
 import (
@@ -10,24 +11,13 @@ import (
 	"io"
 	"log"
 	"os"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/shimt/go-logif"
 )
 
-var levelString []string
-var levelStringWithSpace []string
-
-func init() {
-	var l logif.LogLevel
-	levelString = make([]string, logif.MAXLEVEL+1)
-	levelStringWithSpace = make([]string, logif.MAXLEVEL+1)
-	for l = logif.MINLEVEL; l <= logif.MAXLEVEL; l++ {
-		levelString[l] = "[" + l.String() + "]"
-		levelStringWithSpace[l] = "[" + l.String() + "] "
-	}
-}
-
 const (
 	Ldate         = log.Ldate
 	Ltime         = log.Ltime
@@ -36,6 +26,9 @@ const (
 	Lshortfile    = log.Lshortfile
 	LUTC          = log.LUTC
 	LstdFlags     = log.LstdFlags
+
+	// msgprefix is log.Lmsgprefix, which is exported as Lmsgprefix on Go 1.14 or later.
+	msgprefix = 1 << 6
 )
 
 const (
@@ -43,12 +36,59 @@ const (
 	INFO  = logif.INFO
 	WARN  = logif.WARN
 	ERROR = logif.ERROR
+	OFF   = logif.OFF
 )
 
-// Logger is wrapper for Golang default logger (log.Logger).
+// Logger is a leveled logger writing lines in the format of the standard
+// log.Logger, whose flags and prefix it shares.
+//
+// A Logger formats each line into a pooled buffer and holds its lock only
+// while writing the line, so it can be used simultaneously from multiple
+// goroutines. A call at a disabled level does not allocate, and an enabled
+// call allocates at most once unless Lshortfile or Llongfile is set.
 type Logger struct {
-	entity      *log.Logger
-	outputLevel int32
+	mu  sync.Mutex   // serializes updates of cfg
+	cfg atomic.Value // *config
+
+	out *output
+}
+
+// config is the formatting configuration of a Logger.
+// A config is never modified once stored; setters store an updated copy.
+type config struct {
+	prefix     string
+	prefixFunc func() string
+	flag       int
+	filters    []Filter
+	recorder   *FlightRecorder
+	stats      *Stats
+
+	level      *logif.AtomicLevel
+	printLevel logif.LogLevel
+	verbosity  int
+	vmodule    *vmodule
+
+	levelFormat LevelFormat
+	tags        *levelTags
+
+	timeLayout string
+	location   *time.Location
+	clock      func() time.Time
+
+	callerSkip int
+}
+
+// output is a destination that may be shared by several loggers.
+type output struct {
+	mu sync.Mutex // ensures atomic writes; protects w
+	w  io.Writer
+}
+
+func (o *output) write(p []byte) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	_, err := o.w.Write(p)
+	return err
 }
 
 // verify interface compliance.
@@ -57,29 +97,56 @@ var (
 	_ logif.LoggerModifier        = (*Logger)(nil)
 	_ logif.LeveledLogger         = (*Logger)(nil)
 	_ logif.LeveledLoggerModifier = (*Logger)(nil)
+	_ logif.DepthLogger           = (*Logger)(nil)
 
 	_ logif.Logger         = (*log.Logger)(nil)
 	_ logif.LoggerModifier = (*log.Logger)(nil)
 )
 
+func (l *Logger) config() *config {
+	return l.cfg.Load().(*config)
+}
+
+// update stores a copy of the configuration modified by f.
+func (l *Logger) update(f func(c *config)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c := *l.config()
+	f(&c)
+	l.cfg.Store(&c)
+}
+
 // SetFlags sets the output flags for the logger.
 func (l *Logger) SetFlags(flag int) {
-	l.entity.SetFlags(flag)
+	l.update(func(c *config) { c.flag = flag })
 }
 
 // Flags returns the output flags for the logger.
 func (l *Logger) Flags() int {
-	return l.entity.Flags()
+	return l.config().flag
 }
 
 // SetPrefix sets the output prefix for the logger.
 func (l *Logger) SetPrefix(prefix string) {
-	l.entity.SetPrefix(prefix)
+	l.update(func(c *config) {
+		c.prefix = prefix
+		c.prefixFunc = nil
+	})
 }
 
 // Prefix returns the output prefix for the logger.
 func (l *Logger) Prefix() string {
-	return l.entity.Prefix()
+	return l.config().prefix
+}
+
+// SetPrefixFunc sets a function computing the output prefix of each line,
+// in place of the prefix set by SetPrefix, e.g. from the role of the
+// process. It is called only for lines which are written, after the level
+// check, and must be safe for concurrent use. A nil f restores the prefix
+// set by SetPrefix; a later SetPrefix removes f.
+func (l *Logger) SetPrefixFunc(f func() string) {
+	l.update(func(c *config) { c.prefixFunc = f })
 }
 
 // Output writes the output for a logging event.
@@ -87,104 +154,347 @@ func (l *Logger) Prefix() string {
 // by the flags of the Logger. A newline is appended if the last character
 // of s is not already a newline.
 // Calldepth is used to recover the PC and is provided for generality,
-// although at the moment on all pre-defined paths it will be 4
+// a value of 2 will print the details for the caller of Output.
 func (l *Logger) Output(calldepth int, s string) error {
-	return l.entity.Output(calldepth, s)
+	b := l.header(calldepth, untagged)
+	b.WriteString(s)
+	return l.write(b)
 }
 
 // SetOutput sets the output destination for the logger.
 func (l *Logger) SetOutput(w io.Writer) {
-	l.entity.SetOutput(w)
+	l.out.mu.Lock()
+	defer l.out.mu.Unlock()
+	l.out.w = w
+}
+
+// header starts a new line in a pooled buffer with the prefix, date, time,
+// file and line number selected by the flags, and the tag of level.
+// Calldepth counts the frames between the caller of interest and header.
+func (l *Logger) header(calldepth int, level logif.LogLevel) *buffer {
+	c := l.config()
+
+	var file string
+	var line int
+	if c.flag&(Lshortfile|Llongfile) != 0 {
+		file, line = caller(calldepth + c.callerSkip)
+	}
+
+	return c.begin(level, file, line)
+}
+
+// begin starts a new line like header, with the file and line number
+// given by the caller.
+func (c *config) begin(level logif.LogLevel, file string, line int) *buffer {
+	var now time.Time
+	if c.timed() {
+		now = c.now()
+	}
+
+	b := getBuffer()
+	c.formatHeader(b, c.tag(level), now, file, line)
+	b.msg = len(b.b)
+
+	return b
+}
+
+// finish filters the message in b and terminates the line with a newline.
+func (l *Logger) finish(b *buffer) {
+	l.filter(b)
+
+	if m := b.message(); len(m) == 0 || m[len(m)-1] != '\n' {
+		b.WriteByte('\n')
+	}
+}
+
+// write finishes the untagged line in b, writes it to the output and
+// releases b.
+func (l *Logger) write(b *buffer) error {
+	l.finish(b)
+	return l.send(untagged, b)
 }
 
-func (l *Logger) p(v []interface{}) string {
-	s := fmt.Sprint(v...)
-	l.Output(4, s)
-	return s
+// lwrite writes the leveled record in b, or keeps it in the flight
+// recorder when the level is disabled. A record at or above the trigger
+// level of the flight recorder is preceded by the records kept so far.
+// An untagged record is written as write does.
+func (l *Logger) lwrite(level logif.LogLevel, b *buffer) {
+	if level == untagged {
+		l.write(b)
+		return
+	}
+
+	c := l.config()
+	l.finish(b)
+
+	if !l.Enabled(level) {
+		dropped := c.recorder != nil && c.recorder.record(b.b)
+		putBuffer(b)
+		if c.stats != nil {
+			c.stats.suppress(level)
+			if dropped {
+				c.stats.drop()
+			}
+		}
+		return
+	}
+
+	if c.recorder != nil && level >= c.recorder.trigger {
+		d := getBuffer()
+		c.recorder.dump(d)
+		d.Write(b.b)
+		putBuffer(b)
+		b = d
+	}
+
+	l.send(level, b)
 }
 
-func (l *Logger) pf(f string, v []interface{}) string {
-	s := fmt.Sprintf(f, v...)
-	l.Output(4, s)
-	return s
+// send writes the finished line in b to the output, counts it and
+// releases b.
+func (l *Logger) send(level logif.LogLevel, b *buffer) error {
+	n := len(b.b)
+	err := l.out.write(b.b)
+	putBuffer(b)
+
+	if s := l.config().stats; s != nil {
+		s.emit(level, n, err)
+	}
+
+	return err
 }
 
-func (l *Logger) pl(v []interface{}) string {
-	s := fmt.Sprintln(v...)
-	l.Output(4, s)
-	return s
+// wants reports whether a record of the level must be formatted,
+// either to be written or to be kept by the flight recorder.
+//
+// Untagged is a print level only: a record of the leveled methods at it is
+// never wanted, as it would be written regardless of the output level.
+func (l *Logger) wants(level logif.LogLevel) bool {
+	if level == untagged {
+		return false
+	}
+	if l.Enabled(level) {
+		return true
+	}
+
+	c := l.config()
+	if c.recorder != nil {
+		return true
+	}
+	if c.stats != nil {
+		c.stats.suppress(level)
+	}
+
+	return false
+}
+
+// formatHeader writes the line header to b in following order:
+//   - tag (if placed at the line start),
+//   - prefix (if Lmsgprefix is unset),
+//   - date and/or time (if corresponding flags are provided, or time
+//     formatted with the time layout if it is set),
+//   - file and line number (if corresponding flags are provided),
+//   - prefix (if Lmsgprefix is set),
+//   - tag (if placed before the message).
+//
+// A tag placed before the prefix precedes the prefix wherever it is.
+func (c *config) formatHeader(b *buffer, tag string, t time.Time, file string, line int) {
+	flag := c.flag
+	placement := c.levelFormat.Placement
+	prefix := c.prefix
+	if c.prefixFunc != nil {
+		prefix = c.prefixFunc()
+	}
+
+	if placement == LevelLineStart {
+		b.WriteString(tag)
+	}
+	if flag&msgprefix == 0 {
+		if placement == LevelBeforePrefix {
+			b.WriteString(tag)
+		}
+		b.WriteString(prefix)
+	}
+	if c.timeLayout != "" {
+		appendTime(b, t, c.timeLayout)
+		b.WriteByte(' ')
+	} else if flag&(Ldate|Ltime|Lmicroseconds) != 0 {
+		if flag&Ldate != 0 {
+			year, month, day := t.Date()
+			b.appendInt(year, 4)
+			b.WriteByte('/')
+			b.appendInt(int(month), 2)
+			b.WriteByte('/')
+			b.appendInt(day, 2)
+			b.WriteByte(' ')
+		}
+		if flag&(Ltime|Lmicroseconds) != 0 {
+			hour, min, sec := t.Clock()
+			b.appendInt(hour, 2)
+			b.WriteByte(':')
+			b.appendInt(min, 2)
+			b.WriteByte(':')
+			b.appendInt(sec, 2)
+			if flag&Lmicroseconds != 0 {
+				b.WriteByte('.')
+				b.appendInt(t.Nanosecond()/1e3, 6)
+			}
+			b.WriteByte(' ')
+		}
+	}
+	if flag&(Lshortfile|Llongfile) != 0 {
+		if flag&Lshortfile != 0 {
+			for i := len(file) - 1; i > 0; i-- {
+				if file[i] == '/' {
+					file = file[i+1:]
+					break
+				}
+			}
+		}
+		b.WriteString(file)
+		b.WriteByte(':')
+		b.appendInt(line, -1)
+		b.WriteString(": ")
+	}
+	if flag&msgprefix != 0 {
+		if placement == LevelBeforePrefix {
+			b.WriteString(tag)
+		}
+		b.WriteString(prefix)
+	}
+	if placement == LevelBeforeMessage {
+		b.WriteString(tag)
+	}
 }
 
 // Print calls l.Output to print to the logger. Arguments are handled in the manner of fmt.Print.
 func (l *Logger) Print(v ...interface{}) {
-	l.p(v)
+	level, ok := l.printLevel()
+	if !ok {
+		return
+	}
+
+	l.lp(level, v)
 }
 
 // Printf calls l.Output to print to the logger. Arguments are handled in the manner of fmt.Printf.
 func (l *Logger) Printf(format string, v ...interface{}) {
-	l.pf(format, v)
+	level, ok := l.printLevel()
+	if !ok {
+		return
+	}
+
+	l.lpf(level, format, v)
 }
 
 // Println calls l.Output to print to the logger. Arguments are handled in the manner of fmt.Println.
 func (l *Logger) Println(v ...interface{}) {
-	l.pl(v)
+	level, ok := l.printLevel()
+	if !ok {
+		return
+	}
+
+	l.lpl(level, v)
 }
 
 // Fatal write message(level=FATAL) to the logger followed by a call to os.Exit(1).
 // Arguments are handled in the manner of fmt.Print.
 func (l *Logger) Fatal(v ...interface{}) {
-	l.p(v)
+	l.lp(untagged, v)
 	os.Exit(1)
 }
 
 // Fatalf write message(level=FATAL) to the logger followed by a call to os.Exit(1).
 // Arguments are handled in the manner of fmt.Printf.
 func (l *Logger) Fatalf(format string, v ...interface{}) {
-	l.pf(format, v)
+	l.lpf(untagged, format, v)
 	os.Exit(1)
 }
 
 // Fatalln iwrite message(level=FATAL) to the logger followed by a call to os.Exit(1).
 // Arguments are handled in the manner of fmt.Println.
 func (l *Logger) Fatalln(v ...interface{}) {
-	l.pl(v)
+	l.lpl(untagged, v)
 	os.Exit(1)
 }
 
 // Panic write message(level=PANIC) to the logger followed by a call to panic().
 // Arguments are handled in the manner of fmt.Print.
 func (l *Logger) Panic(v ...interface{}) {
-	panic(l.p(v))
+	s := fmt.Sprint(logif.ResolveLazy(v)...)
+	l.Output(3, s)
+	panic(s)
 }
 
 // Panicf write message(level=PANIC) to the logger followed by a call to panic().
 // Arguments are handled in the manner of fmt.Printf.
 func (l *Logger) Panicf(format string, v ...interface{}) {
-	panic(l.pf(format, v))
+	s := fmt.Sprintf(format, logif.ResolveLazy(v)...)
+	l.Output(3, s)
+	panic(s)
 }
 
 // Panicln write message(level=PANIC) to the logger followed by a call to panic().
 // Arguments are handled in the manner of fmt.Print.
 func (l *Logger) Panicln(v ...interface{}) {
-	panic(l.pl(v))
+	s := fmt.Sprintln(logif.ResolveLazy(v)...)
+	l.Output(3, s)
+	panic(s)
 }
 
 func (l *Logger) lp(level logif.LogLevel, v []interface{}) {
-	l.Output(4, levelStringWithSpace[level]+fmt.Sprint(v...))
+	b := l.header(3, level)
+	fmt.Fprint(b, logif.ResolveLazy(v)...)
+	l.lwrite(level, b)
 }
 
 func (l *Logger) lpf(level logif.LogLevel, format string, v []interface{}) {
-	l.Output(4, fmt.Sprintf(levelStringWithSpace[level]+format, v...))
+	b := l.header(3, level)
+	fmt.Fprintf(b, format, logif.ResolveLazy(v)...)
+	l.lwrite(level, b)
 }
 
 func (l *Logger) lpl(level logif.LogLevel, v []interface{}) {
-	l.Output(4, levelStringWithSpace[level]+fmt.Sprintln(v...))
+	b := l.header(3, level)
+	fmt.Fprintln(b, logif.ResolveLazy(v)...)
+	l.lwrite(level, b)
+}
+
+// Log write message(level=level) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+// A message at Untagged is dropped.
+func (l *Logger) Log(level logif.LogLevel, v ...interface{}) {
+	if !l.wants(level) {
+		return
+	}
+
+	l.lp(level, v)
+}
+
+// Logf write message(level=level) to the logger.
+// Arguments are handled in the manner of fmt.Printf.
+func (l *Logger) Logf(level logif.LogLevel, format string, v ...interface{}) {
+	if !l.wants(level) {
+		return
+	}
+
+	l.lpf(level, format, v)
+}
+
+// Logln write message(level=level) to the logger.
+// Arguments are handled in the manner of fmt.Println.
+func (l *Logger) Logln(level logif.LogLevel, v ...interface{}) {
+	if !l.wants(level) {
+		return
+	}
+
+	l.lpl(level, v)
 }
 
 // Debug write message(level=DEBUG) to the logger.
 // Arguments are handled in the manner of fmt.Print.
 func (l *Logger) Debug(v ...interface{}) {
-	if l.OutputLevel() > logif.DEBUG {
+	if !l.wants(logif.DEBUG) {
 		return
 	}
 
@@ -194,7 +504,7 @@ func (l *Logger) Debug(v ...interface{}) {
 // Debugf write message(level=DEBUG) to the logger.
 // Arguments are handled in the manner of fmt.Printf.
 func (l *Logger) Debugf(format string, v ...interface{}) {
-	if l.OutputLevel() > logif.DEBUG {
+	if !l.wants(logif.DEBUG) {
 		return
 	}
 
@@ -204,7 +514,7 @@ func (l *Logger) Debugf(format string, v ...interface{}) {
 // Debugln write message(level=DEBUG) to the logger.
 // Arguments are handled in the manner of fmt.Println.
 func (l *Logger) Debugln(v ...interface{}) {
-	if l.OutputLevel() > logif.DEBUG {
+	if !l.wants(logif.DEBUG) {
 		return
 	}
 
@@ -214,7 +524,7 @@ func (l *Logger) Debugln(v ...interface{}) {
 // Info write message(level=INFO) to the logger.
 // Arguments are handled in the manner of fmt.Print.
 func (l *Logger) Info(v ...interface{}) {
-	if l.OutputLevel() > logif.INFO {
+	if !l.wants(logif.INFO) {
 		return
 	}
 
@@ -224,7 +534,7 @@ func (l *Logger) Info(v ...interface{}) {
 // Infof write message(level=INFO) to the logger.
 // Arguments are handled in the manner of fmt.Printf.
 func (l *Logger) Infof(format string, v ...interface{}) {
-	if l.OutputLevel() > logif.INFO {
+	if !l.wants(logif.INFO) {
 		return
 	}
 
@@ -234,7 +544,7 @@ func (l *Logger) Infof(format string, v ...interface{}) {
 // Infoln write message(level=INFO) to the logger.
 // Arguments are handled in the manner of fmt.Println.
 func (l *Logger) Infoln(v ...interface{}) {
-	if l.OutputLevel() > logif.INFO {
+	if !l.wants(logif.INFO) {
 		return
 	}
 
@@ -244,7 +554,7 @@ func (l *Logger) Infoln(v ...interface{}) {
 // Warn write message(level=WARN) to the logger.
 // Arguments are handled in the manner of fmt.Print.
 func (l *Logger) Warn(v ...interface{}) {
-	if l.OutputLevel() > logif.WARN {
+	if !l.wants(logif.WARN) {
 		return
 	}
 
@@ -254,7 +564,7 @@ func (l *Logger) Warn(v ...interface{}) {
 // Warnf write message(level=WARN) to the logger.
 // Arguments are handled in the manner of fmt.Printf.
 func (l *Logger) Warnf(format string, v ...interface{}) {
-	if l.OutputLevel() > logif.WARN {
+	if !l.wants(logif.WARN) {
 		return
 	}
 
@@ -264,7 +574,7 @@ func (l *Logger) Warnf(format string, v ...interface{}) {
 // Warnln write message(level=WARN) to the logger.
 // Arguments are handled in the manner of fmt.Println.
 func (l *Logger) Warnln(v ...interface{}) {
-	if l.OutputLevel() > logif.WARN {
+	if !l.wants(logif.WARN) {
 		return
 	}
 
@@ -274,7 +584,7 @@ func (l *Logger) Warnln(v ...interface{}) {
 // Error write message(level=ERROR) to the logger.
 // Arguments are handled in the manner of fmt.Print.
 func (l *Logger) Error(v ...interface{}) {
-	if l.OutputLevel() > logif.ERROR {
+	if !l.wants(logif.ERROR) {
 		return
 	}
 
@@ -284,7 +594,7 @@ func (l *Logger) Error(v ...interface{}) {
 // Errorf write message(level=ERROR) to the logger.
 // Arguments are handled in the manner of fmt.Printf.
 func (l *Logger) Errorf(format string, v ...interface{}) {
-	if l.OutputLevel() > logif.ERROR {
+	if !l.wants(logif.ERROR) {
 		return
 	}
 
@@ -294,27 +604,156 @@ func (l *Logger) Errorf(format string, v ...interface{}) {
 // Errorln write message(level=ERROR) to the logger.
 // Arguments are handled in the manner of fmt.Println.
 func (l *Logger) Errorln(v ...interface{}) {
-	if l.OutputLevel() > logif.ERROR {
+	if !l.wants(logif.ERROR) {
 		return
 	}
 
 	l.lpl(logif.ERROR, v)
 }
 
+// Enabled reports whether a message of the level is written to the logger.
+func (l *Logger) Enabled(level logif.LogLevel) bool {
+	return l.config().level.Enabled(level)
+}
+
+// printLevel returns the level of the Print family, and whether it is
+// written to the logger. Untagged records are written unless the output
+// level is OFF.
+func (l *Logger) printLevel() (logif.LogLevel, bool) {
+	level := l.config().printLevel
+	if level == untagged {
+		return level, l.OutputLevel() != logif.OFF
+	}
+
+	return level, l.wants(level)
+}
+
+// SetPrintLevel sets the level of the Print family. With a level other than
+// Untagged, Print, Printf and Println write records tagged and gated as
+// the leveled methods do, which quiets legacy code using Print.
+//
+// With Untagged, the default, they write records without a level tag at
+// every output level but OFF. Fatal and Panic are always written untagged.
+func (l *Logger) SetPrintLevel(level logif.LogLevel) {
+	if level != untagged {
+		level = logif.ClampLevel(level)
+	}
+
+	l.update(func(c *config) {
+		c.printLevel = level
+	})
+}
+
+// PrintLevel returns the level of the Print family, or Untagged.
+func (l *Logger) PrintLevel() logif.LogLevel {
+	return l.config().printLevel
+}
+
 // SetOutputLevel set output level
+//
+// OFF silences the logger, including the Print family. A level which is
+// neither a built-in nor a custom level is raised to the next level above
+// it, or to OFF if there is none.
+//
+// The change is made to the AtomicLevel of the logger, noting the caller as
+// who made it, and so to every logger sharing it.
 func (l *Logger) SetOutputLevel(level logif.LogLevel) {
-	atomic.StoreInt32(&l.outputLevel, int32(level))
+	l.setOutputLevel(level, callerName(1))
+}
+
+func (l *Logger) setOutputLevel(level logif.LogLevel, by string) {
+	l.config().level.SetLevelBy(level, by)
 }
 
 // OutputLevel set output level
 func (l *Logger) OutputLevel() logif.LogLevel {
-	return logif.LogLevel(atomic.LoadInt32(&l.outputLevel))
+	return l.config().level.Level()
+}
+
+// SetAtomicLevel makes the logger use a as its output level, which may be
+// shared with other loggers so that changing it once changes all of them.
+// A nil a gives the logger a level of its own, set to its current level.
+func (l *Logger) SetAtomicLevel(a *logif.AtomicLevel) {
+	if a == nil {
+		a = logif.NewAtomicLevel(l.OutputLevel())
+	}
+
+	l.update(func(c *config) {
+		c.level = a
+	})
+}
+
+// AtomicLevel returns the output level of the logger.
+func (l *Logger) AtomicLevel() *logif.AtomicLevel {
+	return l.config().level
 }
 
 // New create new logger instance.
 func New(out io.Writer, prefix string, flag int) *Logger {
-	return &Logger{
-		entity:      log.New(out, prefix, flag),
-		outputLevel: int32(logif.WARN),
+	l := &Logger{
+		out: &output{w: out},
+	}
+	l.cfg.Store(&config{
+		prefix:     prefix,
+		flag:       flag,
+		tags:       defaultLevelTags,
+		level:      logif.NewAtomicLevel(logif.WARN),
+		printLevel: untagged,
+	})
+
+	return l
+}
+
+// Clone returns a new logger writing to the same output as l, with the
+// current settings of l. Settings changed on either logger afterwards do
+// not affect the other, but the output is shared: a change made by
+// SetOutput is seen by both, and their lines are written under one lock.
+//
+// The clone has its own AtomicLevel set to the output level of l, so that
+// it leaves a level shared by l with SetAtomicLevel. The With functions
+// below return clones.
+func (l *Logger) Clone() *Logger {
+	d := l.derive()
+	d.update(func(c *config) {
+		c.level = logif.NewAtomicLevel(c.level.Level())
+	})
+
+	return d
+}
+
+// WithPrefix returns a clone of l with the output prefix prefix.
+func (l *Logger) WithPrefix(prefix string) *Logger {
+	d := l.Clone()
+	d.SetPrefix(prefix)
+
+	return d
+}
+
+// WithLevel returns a clone of l with the output level level.
+func (l *Logger) WithLevel(level logif.LogLevel) *Logger {
+	d := l.Clone()
+	d.setOutputLevel(level, callerName(1))
+
+	return d
+}
+
+// WithFlags returns a clone of l with the output flags flag.
+func (l *Logger) WithFlags(flag int) *Logger {
+	d := l.Clone()
+	d.SetFlags(flag)
+
+	return d
+}
+
+// derive returns a new logger writing to the same output as l, starting
+// with the current settings of l. It shares the AtomicLevel of l, so that
+// changes of the output level of either logger are seen by both.
+func (l *Logger) derive() *Logger {
+	d := &Logger{
+		out: l.out,
 	}
+	c := *l.config()
+	d.cfg.Store(&c)
+
+	return d
 }
@@ -6,9 +6,11 @@ package gologif
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/shimt/go-logif"
@@ -340,6 +342,86 @@ func Test_Logger_Errorln(t *testing.T) {
 	}
 }
 
+func Test_Logger_Log(t *testing.T) {
+	tests := []struct {
+		name  string
+		level logif.LogLevel
+		f     func(l *Logger, level logif.LogLevel)
+		want  string
+	}{
+		{"Log", WARN, func(l *Logger, level logif.LogLevel) { l.Log(level, "string") }, "[WARN] string\n"},
+		{"Logf", ERROR, func(l *Logger, level logif.LogLevel) { l.Logf(level, "%s", "string") }, "[ERROR] string\n"},
+		{"Logln", WARN, func(l *Logger, level logif.LogLevel) { l.Logln(level, "string") }, "[WARN] string\n"},
+		{"disabled", DEBUG, func(l *Logger, level logif.LogLevel) { l.Log(level, "string") }, ""},
+		{"gap", 1, func(l *Logger, level logif.LogLevel) { l.Log(level, "string") }, ""},
+		{"above", logif.MAXLEVEL + 3, func(l *Logger, level logif.LogLevel) { l.Log(level, "string") }, "[LogLevel(7)] string\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			tt.f(New(b, "", 0), tt.level)
+
+			if got := b.String(); got != tt.want {
+				t.Errorf("Logger.%s = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Logger_Enabled(t *testing.T) {
+	tests := []struct {
+		name        string
+		outputLevel logif.LogLevel
+		level       logif.LogLevel
+		want        bool
+	}{
+		{"below", INFO, DEBUG, false},
+		{"equal", INFO, INFO, true},
+		{"above", INFO, ERROR, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New(ioutil.Discard, "", LstdFlags)
+			l.SetOutputLevel(tt.outputLevel)
+
+			if got := l.Enabled(tt.level); got != tt.want {
+				t.Errorf("Logger.Enabled(%v) = %v, want %v", tt.level, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Logger_lazy(t *testing.T) {
+	called := 0
+	lazy := logif.Lazy(func() interface{} {
+		called++
+		return "lazy"
+	})
+	fn := func() interface{} {
+		called++
+		return 42
+	}
+
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+	l.SetOutputLevel(INFO)
+
+	l.Debugf("%s %d", lazy, fn)
+	if called != 0 {
+		t.Fatalf("lazy arguments evaluated %d times for disabled level", called)
+	}
+
+	l.Infof("%-6s|%03d", lazy, fn)
+	l.Info(lazy, lazy)
+	if want := "[INFO] lazy  |042\n[INFO] lazylazy\n"; b.String() != want {
+		t.Errorf("got = %q, want %q", b.String(), want)
+	}
+
+	if got, want := fmt.Sprintf("[%5.2f]", logif.Lazy(func() interface{} { return 3.14159 })), "[ 3.14]"; got != want {
+		t.Errorf("Lazy.Format = %q, want %q", got, want)
+	}
+}
+
 func Test_Logger_calldepth(t *testing.T) {
 	want := "gologif_test.go:"
 	b := &bytes.Buffer{}
@@ -352,6 +434,77 @@ func Test_Logger_calldepth(t *testing.T) {
 	}
 }
 
+func Test_Logger_header(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		flag   int
+	}{
+		{"none", "", 0},
+		{"prefix", "prefix: ", 0},
+		{"shortfile", "prefix: ", Lshortfile},
+		{"msgprefix", "prefix: ", Lshortfile | msgprefix},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := &bytes.Buffer{}
+			got := &bytes.Buffer{}
+			// keep both calls on one line so that the file and line match.
+			_, _ = log.New(want, tt.prefix, tt.flag).Output(1, "message"), New(got, tt.prefix, tt.flag).Output(2, "message")
+
+			if got.String() != want.String() {
+				t.Errorf("got = %q, want %q", got.String(), want.String())
+			}
+		})
+	}
+}
+
+func Test_Logger_allocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations are not counted reliably with the race detector")
+	}
+
+	l := New(ioutil.Discard, "", LstdFlags|Lmicroseconds)
+	l.SetOutputLevel(INFO)
+
+	tests := []struct {
+		name string
+		f    func()
+		max  float64
+	}{
+		{"disabled", func() { l.Debug("test") }, 0},
+		{"disabledf", func() { l.Debugf("%s %d", "test", 1) }, 0},
+		{"enabled", func() { l.Error("test") }, 1},
+		{"enabledf", func() { l.Errorf("%s %d", "test", 1) }, 1},
+		{"print", func() { l.Print("test") }, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testing.AllocsPerRun(100, tt.f); got > tt.max {
+				t.Errorf("allocs = %v, want <= %v", got, tt.max)
+			}
+		})
+	}
+}
+
+// syncBuffer is a bytes.Buffer safe for concurrent use.
+type syncBuffer struct {
+	mu sync.Mutex
+	b  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.b.String()
+}
+
 func Benchmark_log_Print(b *testing.B) {
 	l := log.New(ioutil.Discard, "", LstdFlags)
 	b.ResetTimer()
@@ -362,6 +515,7 @@ func Benchmark_log_Print(b *testing.B) {
 
 func Benchmark_gologif_Print(b *testing.B) {
 	l := New(ioutil.Discard, "", LstdFlags)
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		l.Print("test")
@@ -370,8 +524,102 @@ func Benchmark_gologif_Print(b *testing.B) {
 
 func Benchmark_gologif_Error(b *testing.B) {
 	l := New(ioutil.Discard, "", LstdFlags)
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		l.Error("test")
 	}
 }
+
+func Benchmark_gologif_Errorf(b *testing.B) {
+	l := New(ioutil.Discard, "", LstdFlags)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Errorf("%s %d", "test", i&0xff)
+	}
+}
+
+func Benchmark_gologif_Debug_disabled(b *testing.B) {
+	l := New(ioutil.Discard, "", LstdFlags)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Debug("test")
+	}
+}
+
+func Benchmark_gologif_Debugf_disabled(b *testing.B) {
+	l := New(ioutil.Discard, "", LstdFlags)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Debugf("%s %d", "test", i&0xff)
+	}
+}
+
+func Test_Logger_Clone(t *testing.T) {
+	b := &syncBuffer{}
+	l := New(b, "main: ", 0)
+	l.SetOutputLevel(INFO)
+
+	c := l.Clone()
+	p := l.WithPrefix("db: ")
+	v := l.WithLevel(ERROR)
+	f := l.WithFlags(Lmsgprefix)
+
+	c.SetPrefix("clone: ")
+	l.Info("l")
+	c.Info("c")
+	p.Info("p")
+	v.Info("v")
+	f.Info("f")
+
+	want := "main: [INFO] l\nclone: [INFO] c\ndb: [INFO] p\nmain: [INFO] f\n"
+	if got := b.String(); got != want {
+		t.Errorf("got = %q, want %q", got, want)
+	}
+	if l.Prefix() != "main: " || l.OutputLevel() != INFO || l.Flags() != 0 || f.Flags() != Lmsgprefix {
+		t.Errorf("settings of a clone leaked to its parent")
+	}
+
+	// the output is shared by the clones.
+	o := &bytes.Buffer{}
+	p.SetOutput(o)
+	l.Info("l")
+	if got := o.String(); got != "main: [INFO] l\n" {
+		t.Errorf("got = %q after SetOutput on a clone", got)
+	}
+}
+
+func Test_Logger_SetPrefixFunc(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := New(b, "static: ", 0)
+
+	calls := 0
+	role := "leader"
+	l.SetPrefixFunc(func() string {
+		calls++
+		return role + ": "
+	})
+
+	l.Warn("elected")
+	role = "follower"
+	l.Print("stepped down")
+	l.Debug("disabled")
+
+	want := "leader: [WARN] elected\nfollower: stepped down\n"
+	if got := b.String(); got != want {
+		t.Errorf("got = %q, want %q", got, want)
+	}
+	if calls != 2 {
+		t.Errorf("prefix function called %d times, want 2", calls)
+	}
+
+	b.Reset()
+	l.SetPrefixFunc(nil)
+	l.Print("static")
+	if got := b.String(); got != "static: static\n" {
+		t.Errorf("got = %q after SetPrefixFunc(nil)", got)
+	}
+}
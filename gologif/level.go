@@ -0,0 +1,43 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"math"
+
+	"github.com/shimt/go-logif"
+)
+
+// untagged is the level of the records of the Print family, which have no
+// level tag.
+const untagged = logif.LogLevel(math.MinInt32)
+
+// Untagged is the print level with which the Print family writes records
+// without a level tag, regardless of the output level. See SetPrintLevel.
+const Untagged = untagged
+
+// levels are the built-in levels in ascending order.
+var levels = []logif.LogLevel{DEBUG, INFO, WARN, ERROR}
+
+// nextLevel returns the known level next to level, above it if up is true
+// and below it otherwise. Level is returned when there is no such level.
+func nextLevel(level logif.LogLevel, up bool) logif.LogLevel {
+	known := logif.Levels()
+	if up {
+		for _, v := range known {
+			if v > level {
+				return v
+			}
+		}
+	} else {
+		for i := len(known) - 1; i >= 0; i-- {
+			if known[i] < level {
+				return known[i]
+			}
+		}
+	}
+
+	return level
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/shimt/go-logif"
+)
+
+// critical is a custom level registered by the tests which use it.
+const critical = logif.ERROR + 10
+
+// registerLevel registers level as name until the end of the test.
+func registerLevel(t *testing.T, level logif.LogLevel, name string) {
+	t.Helper()
+
+	if err := logif.RegisterLevel(level, name); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { logif.UnregisterLevel(level) })
+}
+
+func Test_nextLevel(t *testing.T) {
+	tests := []struct {
+		level logif.LogLevel
+		up    bool
+		want  logif.LogLevel
+	}{
+		{DEBUG, false, DEBUG},
+		{INFO, false, DEBUG},
+		{ERROR, false, WARN},
+		{ERROR, true, ERROR},
+		{DEBUG, true, INFO},
+		{1, true, INFO},
+	}
+	for _, tt := range tests {
+		if got := nextLevel(tt.level, tt.up); got != tt.want {
+			t.Errorf("nextLevel(%v, %v) = %v, want %v", tt.level, tt.up, got, tt.want)
+		}
+	}
+}
+
+func Test_nextLevel_custom(t *testing.T) {
+	registerLevel(t, critical, "CRITICAL")
+
+	tests := []struct {
+		level logif.LogLevel
+		up    bool
+		want  logif.LogLevel
+	}{
+		{ERROR, true, critical},
+		{critical, true, critical},
+		{critical, false, ERROR},
+	}
+	for _, tt := range tests {
+		if got := nextLevel(tt.level, tt.up); got != tt.want {
+			t.Errorf("nextLevel(%v, %v) = %v, want %v", tt.level, tt.up, got, tt.want)
+		}
+	}
+}
+
+func Test_Logger_OFF(t *testing.T) {
+	registerLevel(t, critical, "CRITICAL")
+
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+	l.SetOutputLevel(OFF)
+
+	l.Print("print")
+	l.Printf("printf")
+	l.Println("println")
+	l.Error("error")
+	l.Log(critical, "critical")
+	l.Log(OFF, "off")
+
+	if b.Len() != 0 {
+		t.Errorf("got = %q, want no output", b.String())
+	}
+}
+
+func Test_Logger_customLevel(t *testing.T) {
+	registerLevel(t, critical, "CRITICAL")
+
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+
+	want := "[CRITICAL] message\n"
+	if l.Log(critical, "message"); b.String() != want {
+		t.Errorf("got = %q, want %q", b.String(), want)
+	}
+
+	if level, err := logif.ParseLogLevel("critical"); level != critical || err != nil {
+		t.Errorf("ParseLogLevel = %v, %v, want %v", level, err, critical)
+	}
+	if err := logif.RegisterLevel(critical+1, "Critical"); err == nil {
+		t.Errorf("RegisterLevel of a duplicate name succeeded")
+	}
+	if err := logif.RegisterLevel(WARN, "NOTICE"); err == nil {
+		t.Errorf("RegisterLevel of a used level succeeded")
+	}
+}
+
+func Test_Logger_customLevel_builtinRange(t *testing.T) {
+	const detail = logif.DEBUG + 1
+
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+	l.SetOutputLevel(DEBUG)
+
+	// the tags of the built-in levels are rendered before detail is
+	// registered.
+	registerLevel(t, detail, "DETAIL")
+
+	if l.Log(detail, "message"); b.String() != "[DETAIL] message\n" {
+		t.Errorf("got = %q, want %q", b.String(), "[DETAIL] message\n")
+	}
+
+	b.Reset()
+	l.SetLevelFormat(LevelFormat{Style: LevelBare, Lower: true})
+	if l.Log(detail, "message"); b.String() != "detail message\n" {
+		t.Errorf("got = %q, want %q", b.String(), "detail message\n")
+	}
+}
+
+func Test_Logger_SetPrintLevel(t *testing.T) {
+	registerLevel(t, critical, "CRITICAL")
+
+	tests := []struct {
+		name        string
+		printLevel  logif.LogLevel
+		outputLevel logif.LogLevel
+		want        string
+	}{
+		{"untagged", Untagged, ERROR, "print\n"},
+		{"untagged OFF", Untagged, OFF, ""},
+		{"enabled", INFO, INFO, "[INFO] print\n"},
+		{"disabled", INFO, WARN, ""},
+		{"custom", critical, ERROR, "[CRITICAL] print\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			l := New(b, "", 0)
+			l.SetPrintLevel(tt.printLevel)
+			l.SetOutputLevel(tt.outputLevel)
+
+			if l.Print("print"); b.String() != tt.want {
+				t.Errorf("got = %q, want %q", b.String(), tt.want)
+			}
+			if got := l.PrintLevel(); got != tt.printLevel {
+				t.Errorf("PrintLevel = %v, want %v", got, tt.printLevel)
+			}
+		})
+	}
+}
+
+func Test_Logger_SetAtomicLevel(t *testing.T) {
+	a := logif.NewAtomicLevel(WARN)
+
+	var changes []logif.LevelChange
+	cancel := a.Subscribe(func(c logif.LevelChange) {
+		changes = append(changes, c)
+	})
+	defer cancel()
+
+	l1, l2 := New(&bytes.Buffer{}, "", 0), New(&bytes.Buffer{}, "", 0)
+	l1.SetAtomicLevel(a)
+	l2.SetAtomicLevel(a)
+	d := l1.Clone()
+
+	l1.SetOutputLevel(DEBUG)
+	if got := l2.OutputLevel(); got != DEBUG {
+		t.Errorf("shared OutputLevel = %v, want %v", got, DEBUG)
+	}
+	if got := d.OutputLevel(); got != WARN {
+		t.Errorf("cloned OutputLevel = %v, want %v", got, WARN)
+	}
+
+	if len(changes) != 1 {
+		t.Fatalf("got %d changes, want 1", len(changes))
+	}
+	if c := changes[0]; c.From != WARN || c.To != DEBUG || !strings.Contains(c.By, "level_test.go:") || c.Time.IsZero() {
+		t.Errorf("change = %+v", c)
+	}
+
+	l2.SetAtomicLevel(nil)
+	a.SetLevelBy(ERROR, "test")
+	if got := l2.OutputLevel(); got != DEBUG {
+		t.Errorf("detached OutputLevel = %v, want %v", got, DEBUG)
+	}
+	if c := changes[len(changes)-1]; c.By != "test" {
+		t.Errorf("By = %q, want %q", c.By, "test")
+	}
+}
+
+func Test_Logger_derive_sharesLevel(t *testing.T) {
+	a := logif.NewAtomicLevel(WARN)
+	l := New(&bytes.Buffer{}, "", 0)
+	l.SetAtomicLevel(a)
+
+	skip, rec, clone := l.WithCallerSkip(1), l.WithFlightRecorder(NewFlightRecorder(1, ERROR)), l.Clone()
+
+	// the transparent derivations follow the level of l, and the AtomicLevel
+	// given to it; a clone keeps its own.
+	l.SetOutputLevel(DEBUG)
+	if skip.OutputLevel() != DEBUG || rec.OutputLevel() != DEBUG {
+		t.Errorf("OutputLevel = %v, %v, want DEBUG", skip.OutputLevel(), rec.OutputLevel())
+	}
+	a.SetLevel(ERROR)
+	if skip.AtomicLevel() != a || rec.OutputLevel() != ERROR {
+		t.Errorf("derived loggers left the shared AtomicLevel")
+	}
+	if clone.OutputLevel() != WARN || clone.AtomicLevel() == a {
+		t.Errorf("clone follows the level of l")
+	}
+}
+
+func Test_Logger_Log_untagged(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+	l.SetFlightRecorder(NewFlightRecorder(10, ERROR))
+	l.SetOutputLevel(OFF)
+
+	// Untagged is not a level of the leveled methods.
+	l.Log(Untagged, "log")
+	l.Logf(Untagged, "logf")
+	l.LogDepth(0, Untagged, "depth")
+	l.SetOutputLevel(DEBUG)
+	l.Logln(Untagged, "logln")
+	l.Error("error")
+
+	if want := "[ERROR] error\n"; b.String() != want {
+		t.Errorf("got = %q, want %q", b.String(), want)
+	}
+	if err := logif.RegisterLevel(Untagged, "UNTAGGED"); err == nil {
+		t.Errorf("RegisterLevel of Untagged succeeded")
+	}
+}
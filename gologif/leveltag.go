@@ -0,0 +1,217 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/shimt/go-logif"
+)
+
+// LevelStyle is how the name of a level is rendered in the level tag.
+type LevelStyle int
+
+const (
+	// LevelBracket the name in brackets: "[WARN]".
+	LevelBracket LevelStyle = iota
+	// LevelBare the name as is: "WARN".
+	LevelBare
+	// LevelLetter the first letter of the name: "W".
+	LevelLetter
+)
+
+// LevelPlacement is where the level tag is placed in a line.
+type LevelPlacement int
+
+const (
+	// LevelBeforeMessage the tag starts the message, after the header.
+	// With Lmsgprefix the prefix comes before the tag.
+	//
+	//	prefix 2009/01/23 01:23:23 [WARN] message
+	//	2009/01/23 01:23:23 prefix [WARN] message (Lmsgprefix)
+	LevelBeforeMessage LevelPlacement = iota
+	// LevelBeforePrefix the tag is placed just before the prefix, wherever
+	// Lmsgprefix puts the prefix.
+	//
+	//	[WARN] prefix 2009/01/23 01:23:23 message
+	//	2009/01/23 01:23:23 [WARN] prefix message (Lmsgprefix)
+	LevelBeforePrefix
+	// LevelLineStart the tag starts the line.
+	//
+	//	[WARN] prefix 2009/01/23 01:23:23 message
+	//	[WARN] 2009/01/23 01:23:23 prefix message (Lmsgprefix)
+	LevelLineStart
+)
+
+var (
+	levelStyleNames     = []string{"bracket", "bare", "letter"}
+	levelPlacementNames = []string{"message", "prefix", "start"}
+)
+
+// String returns the name of the style: "bracket", "bare" or "letter".
+func (s LevelStyle) String() string {
+	if s >= 0 && int(s) < len(levelStyleNames) {
+		return levelStyleNames[s]
+	}
+	return "LevelStyle(" + strconv.Itoa(int(s)) + ")"
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LevelStyle) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LevelStyle) UnmarshalText(text []byte) error {
+	i, err := parseName(levelStyleNames, string(text), "level style")
+	if err != nil {
+		return err
+	}
+
+	*s = LevelStyle(i)
+	return nil
+}
+
+// String returns the name of the placement: "message", "prefix" or "start".
+func (p LevelPlacement) String() string {
+	if p >= 0 && int(p) < len(levelPlacementNames) {
+		return levelPlacementNames[p]
+	}
+	return "LevelPlacement(" + strconv.Itoa(int(p)) + ")"
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p LevelPlacement) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *LevelPlacement) UnmarshalText(text []byte) error {
+	i, err := parseName(levelPlacementNames, string(text), "level placement")
+	if err != nil {
+		return err
+	}
+
+	*p = LevelPlacement(i)
+	return nil
+}
+
+// parseName returns the index of s in names, case-insensitive.
+func parseName(names []string, s, what string) (int, error) {
+	for i, n := range names {
+		if strings.EqualFold(s, n) {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("gologif: unknown %s %q", what, s)
+}
+
+// LevelFormat is the layout of the level tag of the records of leveled
+// logging. The zero value is the default layout, "[WARN] " before the
+// message.
+type LevelFormat struct {
+	// Style of the level name.
+	Style LevelStyle
+	// Lower renders the level name in lower case.
+	Lower bool
+	// Width pads the tag with spaces to at least Width characters,
+	// so that messages of all levels are aligned.
+	Width int
+	// Names replaces the names of levels, e.g. {WARN: "WARNING"}.
+	Names map[logif.LogLevel]string
+	// Placement of the tag in the line.
+	Placement LevelPlacement
+}
+
+// tag renders the tag of level, including the separating space.
+func (f *LevelFormat) tag(level logif.LogLevel) string {
+	name, ok := f.Names[level]
+	if !ok {
+		name = level.String()
+	}
+
+	if f.Style == LevelLetter && name != "" {
+		_, n := utf8.DecodeRuneInString(name)
+		name = name[:n]
+	}
+	if f.Lower {
+		name = strings.ToLower(name)
+	}
+	if f.Style == LevelBracket {
+		name = "[" + name + "]"
+	}
+	if pad := f.Width - utf8.RuneCountInString(name); pad > 0 {
+		name += strings.Repeat(" ", pad)
+	}
+
+	return name + " "
+}
+
+// levelTags holds the rendered tags of the built-in levels DEBUG to ERROR;
+// the tags of the other levels in between are empty.
+type levelTags [logif.MAXLEVEL + 1]string
+
+var defaultLevelTags = newLevelTags(&LevelFormat{})
+
+func newLevelTags(f *LevelFormat) *levelTags {
+	t := &levelTags{}
+	for _, l := range levels {
+		t[l] = f.tag(l)
+	}
+
+	return t
+}
+
+// tag returns the level tag of level; untagged has none.
+func (c *config) tag(level logif.LogLevel) string {
+	if level == untagged {
+		return ""
+	}
+	if level >= logif.MINLEVEL && level <= logif.MAXLEVEL {
+		if tag := c.tags[level]; tag != "" {
+			return tag
+		}
+	}
+
+	// a custom level, whose name may be registered after the tags are
+	// rendered.
+	return c.levelFormat.tag(level)
+}
+
+// SetLevelFormat sets the layout of the level tag for the logger.
+func (l *Logger) SetLevelFormat(f LevelFormat) {
+	names := make(map[logif.LogLevel]string, len(f.Names))
+	for k, v := range f.Names {
+		names[k] = v
+	}
+	f.Names = names
+
+	l.update(func(c *config) {
+		c.levelFormat = f
+		c.tags = newLevelTags(&f)
+	})
+}
+
+// LevelFormat returns the layout of the level tag for the logger.
+func (l *Logger) LevelFormat() LevelFormat {
+	f := l.config().levelFormat
+
+	names := make(map[logif.LogLevel]string, len(f.Names))
+	for k, v := range f.Names {
+		names[k] = v
+	}
+	f.Names = names
+
+	return f
+}
+
+// SetLevelFormat sets the layout of the level tag for the standard logger.
+func SetLevelFormat(f LevelFormat) {
+	std.SetLevelFormat(f)
+}
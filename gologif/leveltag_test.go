@@ -0,0 +1,61 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/shimt/go-logif"
+)
+
+func Test_Logger_SetLevelFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format LevelFormat
+		flag   int
+		want   string
+	}{
+		{"default", LevelFormat{}, 0, "p: [WARN] message\n"},
+		{"bare", LevelFormat{Style: LevelBare}, 0, "p: WARN message\n"},
+		{"letter", LevelFormat{Style: LevelLetter}, 0, "p: W message\n"},
+		{"lower", LevelFormat{Style: LevelBare, Lower: true}, 0, "p: warn message\n"},
+		{"width", LevelFormat{Width: 7}, 0, "p: [WARN]  message\n"},
+		{"names", LevelFormat{Names: map[logif.LogLevel]string{WARN: "WARNING"}}, 0, "p: [WARNING] message\n"},
+		{"msgprefix", LevelFormat{}, Lmsgprefix, "p: [WARN] message\n"},
+		{"before-prefix", LevelFormat{Placement: LevelBeforePrefix}, 0, "[WARN] p: message\n"},
+		{"before-msgprefix", LevelFormat{Placement: LevelBeforePrefix}, Lmsgprefix | Lshortfile, "leveltag_test.go:38: [WARN] p: message\n"},
+		{"line-start", LevelFormat{Placement: LevelLineStart}, Lmsgprefix | Lshortfile, "[WARN] leveltag_test.go:38: p: message\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			l := New(b, "p: ", tt.flag)
+			l.SetLevelFormat(tt.format)
+
+			l.Warn("message")
+
+			if got := b.String(); got != tt.want {
+				t.Errorf("got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Logger_SetLevelFormat_filter(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+	l.SetLevelFormat(LevelFormat{Placement: LevelLineStart})
+	l.SetFilters(FilterFunc(func(msg []byte) []byte {
+		return bytes.ToUpper(msg)
+	}))
+
+	l.Warn("message")
+	l.Print("message")
+
+	if want := "[WARN] MESSAGE\nMESSAGE\n"; b.String() != want {
+		t.Errorf("got = %q, want %q", b.String(), want)
+	}
+}
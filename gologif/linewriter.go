@@ -0,0 +1,150 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/shimt/go-logif"
+)
+
+// MaxLineLength is the length at which a LineWriter splits a line without
+// a newline into several records.
+const MaxLineLength = 32 << 10
+
+// lineWriter writes each line written to it as a record of a Logger.
+type lineWriter struct {
+	logger *Logger
+	level  logif.LogLevel
+	prefix string
+
+	mu     sync.Mutex
+	buf    []byte // partial line
+	closed bool
+}
+
+// LineWriter returns an io.WriteCloser which writes each line written to it
+// as a record of the logger at level, with prefix before the message.
+//
+// A partial line is kept until its newline is written or the writer is
+// closed, and a line longer than MaxLineLength is split into several
+// records. It is useful to log the output of a subprocess:
+//
+//	stdout := l.LineWriter(gologif.INFO, "stdout: ")
+//	defer stdout.Close()
+//	cmd.Stdout = stdout
+func (l *Logger) LineWriter(level logif.LogLevel, prefix string) io.WriteCloser {
+	return &lineWriter{
+		logger: l,
+		level:  level,
+		prefix: prefix,
+	}
+}
+
+// Write implements io.Writer.
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return 0, io.ErrClosedPipe
+	}
+
+	n := len(p)
+	for {
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			break
+		}
+
+		if len(w.buf) > 0 {
+			w.buf = append(w.buf, p[:i]...)
+			w.emit(w.buf)
+			w.buf = w.buf[:0]
+		} else {
+			w.emit(p[:i])
+		}
+		p = p[i+1:]
+	}
+
+	w.buf = append(w.buf, p...)
+	if len(w.buf) > MaxLineLength {
+		// the full records are written, as emit cuts them, and the rest
+		// is kept.
+		i := 0
+		for len(w.buf)-i > MaxLineLength {
+			i += cutLength(w.buf[i:])
+		}
+		w.emit(w.buf[:i])
+		w.buf = append(w.buf[:0], w.buf[i:]...)
+	}
+
+	return n, nil
+}
+
+// Close writes the partial line, if any, and closes the writer.
+func (w *lineWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+
+	if len(w.buf) > 0 {
+		w.emit(w.buf)
+		w.buf = nil
+	}
+	w.closed = true
+
+	return nil
+}
+
+// emit writes line as records of up to MaxLineLength bytes.
+func (w *lineWriter) emit(line []byte) {
+	line = bytes.TrimSuffix(line, []byte{'\r'})
+
+	for {
+		line = line[w.record(line):]
+		if len(line) == 0 {
+			return
+		}
+	}
+}
+
+// record writes the first record of line, and returns its length.
+func (w *lineWriter) record(line []byte) int {
+	n := cutLength(line)
+
+	if w.logger.wants(w.level) {
+		b := w.logger.header(4, w.level)
+		b.WriteString(w.prefix)
+		b.Write(line[:n])
+		w.logger.lwrite(w.level, b)
+	}
+
+	return n
+}
+
+// cutLength returns the length of the first record of line: all of it if
+// it is not longer than MaxLineLength, or else up to MaxLineLength bytes
+// without splitting a UTF-8 encoded rune.
+func cutLength(line []byte) int {
+	if len(line) <= MaxLineLength {
+		return len(line)
+	}
+
+	for i := MaxLineLength; i > MaxLineLength-utf8.UTFMax; i-- {
+		if utf8.RuneStart(line[i]) {
+			return i
+		}
+	}
+
+	// not UTF-8
+	return MaxLineLength
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func Test_Logger_LineWriter(t *testing.T) {
+	tests := []struct {
+		name   string
+		writes []string
+		want   string
+	}{
+		{"line", []string{"one\n"}, "[WARN] out: one\n"},
+		{"lines", []string{"one\ntwo\n"}, "[WARN] out: one\n[WARN] out: two\n"},
+		{"partial", []string{"o", "ne\ntw", "o\n"}, "[WARN] out: one\n[WARN] out: two\n"},
+		{"crlf", []string{"one\r\n"}, "[WARN] out: one\n"},
+		{"empty", []string{"\n"}, "[WARN] out: \n"},
+		{"close", []string{"one\ntwo"}, "[WARN] out: one\n[WARN] out: two\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			w := New(b, "", 0).LineWriter(WARN, "out: ")
+
+			for _, s := range tt.writes {
+				if n, err := io.WriteString(w, s); n != len(s) || err != nil {
+					t.Fatalf("Write = %v, %v", n, err)
+				}
+			}
+			w.Close()
+
+			if got := b.String(); got != tt.want {
+				t.Errorf("got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Logger_LineWriter_long(t *testing.T) {
+	b := &bytes.Buffer{}
+	w := New(b, "", 0).LineWriter(WARN, "")
+
+	long := strings.Repeat("x", MaxLineLength*2+10)
+	io.WriteString(w, long[:100])
+	io.WriteString(w, long[100:]+"\n")
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %d records, want 3", len(lines))
+	}
+	got := ""
+	for _, line := range lines {
+		got += strings.TrimPrefix(line, "[WARN] ")
+	}
+	if got != long {
+		t.Errorf("records do not add up to the line")
+	}
+}
+
+func Test_Logger_LineWriter_level(t *testing.T) {
+	b := &bytes.Buffer{}
+	w := New(b, "", 0).LineWriter(INFO, "")
+
+	io.WriteString(w, "suppressed\n")
+	if b.Len() != 0 {
+		t.Errorf("got = %q, want no output below WARN", b.String())
+	}
+
+	w.Close()
+	if _, err := io.WriteString(w, "closed\n"); err != io.ErrClosedPipe {
+		t.Errorf("Write after Close = %v, want %v", err, io.ErrClosedPipe)
+	}
+}
+
+func Test_Logger_LineWriter_utf8(t *testing.T) {
+	b := &bytes.Buffer{}
+	w := New(b, "", 0).LineWriter(WARN, "")
+
+	// "あ" is 3 bytes long, which does not divide MaxLineLength.
+	long := "x" + strings.Repeat("あ", MaxLineLength/3+10)
+	io.WriteString(w, long[:100])
+	io.WriteString(w, long[100:])
+	w.Close()
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d records, want 2", len(lines))
+	}
+	got := ""
+	for _, line := range lines {
+		line = strings.TrimPrefix(line, "[WARN] ")
+		if !utf8.ValidString(line) {
+			t.Errorf("record splits a rune: %q...", line[:10])
+		}
+		got += line
+	}
+	if got != long {
+		t.Errorf("records do not add up to the line")
+	}
+}
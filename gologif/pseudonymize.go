@@ -0,0 +1,109 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"regexp"
+	"sync/atomic"
+)
+
+// PseudonymPrefix starts every token written by a Pseudonymizer.
+const PseudonymPrefix = "pii:"
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	ipv4Pattern  = regexp.MustCompile(`\b(?:(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)\.){3}(?:25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)\b`)
+	// ipv6Pattern only finds candidates, they are validated by isIPv6.
+	ipv6Pattern = regexp.MustCompile(`[0-9A-Fa-f]*:[0-9A-Fa-f:.]*`)
+)
+
+// Pseudonymizer is a Filter that replaces personal data in messages with
+// stable tokens, so that lines about the same person can still be
+// correlated without revealing who it is.
+//
+// Email addresses, IPv4 and IPv6 addresses and matches of the configured
+// patterns are replaced with PseudonymPrefix followed by a truncated
+// HMAC-SHA256 of the value. The same value and key always give the same
+// token. When a pattern has capturing groups, only the first matched group
+// is replaced.
+type Pseudonymizer struct {
+	key      atomic.Value // []byte
+	patterns []*regexp.Regexp
+}
+
+// NewPseudonymizer creates a Pseudonymizer keyed by key, detecting
+// patterns in addition to email and IP addresses.
+func NewPseudonymizer(key []byte, patterns []*regexp.Regexp) *Pseudonymizer {
+	p := &Pseudonymizer{
+		patterns: append([]*regexp.Regexp(nil), patterns...),
+	}
+	p.SetKey(key)
+
+	return p
+}
+
+// SetKey replaces the HMAC key. It can be called while logging;
+// tokens of lines written after the call are derived from the new key.
+func (p *Pseudonymizer) SetKey(key []byte) {
+	p.key.Store(append([]byte(nil), key...))
+}
+
+// Token returns the token for value, for looking up lines about a known
+// person.
+func (p *Pseudonymizer) Token(value string) string {
+	return string(p.token([]byte(value)))
+}
+
+func (p *Pseudonymizer) token(value []byte) []byte {
+	mac := hmac.New(sha256.New, p.key.Load().([]byte))
+	mac.Write(value)
+	sum := mac.Sum(nil)
+
+	t := make([]byte, len(PseudonymPrefix)+16)
+	copy(t, PseudonymPrefix)
+	hex.Encode(t[len(PseudonymPrefix):], sum[:8])
+
+	return t
+}
+
+// Filter implements Filter.
+func (p *Pseudonymizer) Filter(msg []byte) []byte {
+	msg = replaceMatches(emailPattern, msg, p.token)
+	msg = replaceMatches(ipv6Pattern, msg, func(m []byte) []byte {
+		if !isIPv6(m) {
+			return m
+		}
+		return p.token(m)
+	})
+	msg = replaceMatches(ipv4Pattern, msg, p.token)
+
+	for _, re := range p.patterns {
+		msg = replaceMatches(re, msg, p.token)
+	}
+
+	return msg
+}
+
+// isIPv6 reports whether m is an IPv6 address with at least two non-empty
+// groups, which leaves out "::1" and text such as "std::string".
+func isIPv6(m []byte) bool {
+	if net.ParseIP(string(m)) == nil {
+		return false
+	}
+
+	groups := 0
+	for _, g := range bytes.Split(m, []byte{':'}) {
+		if len(g) > 0 {
+			groups++
+		}
+	}
+
+	return groups >= 2
+}
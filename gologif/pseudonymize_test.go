@@ -0,0 +1,65 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+)
+
+func Test_Pseudonymizer_Filter(t *testing.T) {
+	p := NewPseudonymizer([]byte("key"), []*regexp.Regexp{regexp.MustCompile(`user_id=(\d+)`)})
+
+	tests := []struct {
+		name string
+		msg  string
+		want string
+	}{
+		{"none", "hello world", "hello world"},
+		{"email", "mail to alice@example.com sent", "mail to " + p.Token("alice@example.com") + " sent"},
+		{"ipv4", "from 192.168.0.1:8080", "from " + p.Token("192.168.0.1") + ":8080"},
+		{"ipv6", "from 2001:db8::1 ok", "from " + p.Token("2001:db8::1") + " ok"},
+		{"mapped", "from ::ffff:10.0.0.1", "from " + p.Token("::ffff:10.0.0.1")},
+		{"time", "at 14:06:21", "at 14:06:21"},
+		{"cpp", "std::string", "std::string"},
+		{"loopback", "listen ::1", "listen ::1"},
+		{"version", "v1.2.3", "v1.2.3"},
+		{"custom", "user_id=42 done", "user_id=" + p.Token("42") + " done"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(p.Filter([]byte(tt.msg))); got != tt.want {
+				t.Errorf("Pseudonymizer.Filter(%q) = %q, want %q", tt.msg, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Pseudonymizer_SetKey(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+	p := NewPseudonymizer([]byte("old"), nil)
+	l.SetFilters(p)
+
+	l.Print("alice@example.com")
+	l.Print("alice@example.com")
+	p.SetKey([]byte("new"))
+	l.Print("alice@example.com")
+
+	lines := bytes.Split(bytes.TrimSuffix(b.Bytes(), []byte("\n")), []byte("\n"))
+	if len(lines) != 3 {
+		t.Fatalf("got %d lines, want 3: %q", len(lines), b.String())
+	}
+	if !bytes.Equal(lines[0], lines[1]) {
+		t.Errorf("tokens differ with the same key: %q, %q", lines[0], lines[1])
+	}
+	if bytes.Equal(lines[1], lines[2]) {
+		t.Errorf("tokens equal after key rotation: %q", lines[2])
+	}
+	if bytes.Contains(b.Bytes(), []byte("alice")) {
+		t.Errorf("output contains personal data: %q", b.String())
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build race
+
+package gologif
+
+// raceEnabled reports whether the race detector is enabled. It drops items
+// of a sync.Pool at random, so that the buffers are allocated again.
+const raceEnabled = true
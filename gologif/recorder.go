@@ -0,0 +1,114 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"sync"
+
+	"github.com/shimt/go-logif"
+)
+
+// FlightMark starts every line written from a flight recorder.
+const FlightMark = "[FLIGHT] "
+
+// FlightRecorder keeps the last records below the output level of a
+// logger in memory, and writes them out, marked with FlightMark, just before
+// a record at or above its trigger level.
+//
+// This gives the DEBUG context leading up to an ERROR while the logger
+// runs at WARN. A recorder attached by SetFlightRecorder collects the
+// records of the whole logger; for a recorder per request, attach a new
+// recorder to a derived logger with WithFlightRecorder.
+type FlightRecorder struct {
+	trigger logif.LogLevel
+
+	mu    sync.Mutex
+	lines [][]byte // ring buffer
+	start int      // index of the oldest line
+	n     int      // number of lines kept
+}
+
+// NewFlightRecorder creates a FlightRecorder keeping up to size records,
+// flushed by records at or above trigger.
+func NewFlightRecorder(size int, trigger logif.LogLevel) *FlightRecorder {
+	if size < 1 {
+		size = 1
+	}
+
+	return &FlightRecorder{
+		trigger: trigger,
+		lines:   make([][]byte, size),
+	}
+}
+
+// Len returns the number of records kept.
+func (r *FlightRecorder) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.n
+}
+
+// Reset discards the records kept.
+func (r *FlightRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.start, r.n = 0, 0
+}
+
+// record keeps a copy of line, dropping the oldest line when full.
+// It reports whether a line was dropped.
+func (r *FlightRecorder) record(line []byte) (dropped bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := (r.start + r.n) % len(r.lines)
+	if r.n == len(r.lines) {
+		r.start = (r.start + 1) % len(r.lines)
+		dropped = true
+	} else {
+		r.n++
+	}
+	r.lines[i] = append(r.lines[i][:0], line...)
+
+	return dropped
+}
+
+// dump appends the lines kept to b, oldest first, and discards them.
+func (r *FlightRecorder) dump(b *buffer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for k := 0; k < r.n; k++ {
+		b.WriteString(FlightMark)
+		b.Write(r.lines[(r.start+k)%len(r.lines)])
+	}
+	r.start, r.n = 0, 0
+}
+
+// SetFlightRecorder attaches r to the logger; nil detaches the recorder.
+func (l *Logger) SetFlightRecorder(r *FlightRecorder) {
+	l.update(func(c *config) { c.recorder = r })
+}
+
+// FlightRecorder returns the flight recorder of the logger, or nil.
+func (l *Logger) FlightRecorder() *FlightRecorder {
+	return l.config().recorder
+}
+
+// WithFlightRecorder returns a new logger writing to the same output as l
+// with the current settings of l and r attached as its flight recorder.
+// It shares the output level of l, so that a later change of either is seen
+// by both.
+func (l *Logger) WithFlightRecorder(r *FlightRecorder) *Logger {
+	d := l.derive()
+	d.SetFlightRecorder(r)
+
+	return d
+}
+
+// SetFlightRecorder attaches r to the standard logger.
+func SetFlightRecorder(r *FlightRecorder) {
+	std.SetFlightRecorder(r)
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"testing"
+)
+
+func Test_Logger_SetFlightRecorder(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+	r := NewFlightRecorder(2, ERROR)
+	l.SetFlightRecorder(r)
+
+	l.Debug("one")
+	l.Infof("two %d", 2)
+	l.Debugln("three")
+	l.Warn("warn")
+
+	if want := "[WARN] warn\n"; b.String() != want {
+		t.Fatalf("got = %q, want %q", b.String(), want)
+	}
+	if r.Len() != 2 {
+		t.Fatalf("Len = %v, want 2", r.Len())
+	}
+
+	l.Error("error")
+	l.Error("again")
+
+	want := "[WARN] warn\n" +
+		FlightMark + "[INFO] two 2\n" +
+		FlightMark + "[DEBUG] three\n" +
+		"[ERROR] error\n" +
+		"[ERROR] again\n"
+	if b.String() != want {
+		t.Errorf("got = %q, want %q", b.String(), want)
+	}
+	if r.Len() != 0 {
+		t.Errorf("Len = %v, want 0 after flush", r.Len())
+	}
+}
+
+func Test_Logger_WithFlightRecorder(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+
+	r1 := l.WithFlightRecorder(NewFlightRecorder(10, ERROR))
+	r2 := l.WithFlightRecorder(NewFlightRecorder(10, ERROR))
+
+	r1.Debug("request 1")
+	r2.Debug("request 2")
+	l.Error("unrelated")
+	r2.Error("failed")
+
+	want := "[ERROR] unrelated\n" +
+		FlightMark + "[DEBUG] request 2\n" +
+		"[ERROR] failed\n"
+	if b.String() != want {
+		t.Errorf("got = %q, want %q", b.String(), want)
+	}
+	if l.FlightRecorder() != nil {
+		t.Errorf("parent logger got a flight recorder")
+	}
+}
+
+func Test_Logger_flightRecorder_allocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations are not counted reliably with the race detector")
+	}
+
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+	l.SetFlightRecorder(NewFlightRecorder(4, ERROR))
+
+	l.Debug("warm up the ring")
+	if got := testing.AllocsPerRun(100, func() { l.Debug("test") }); got > 0 {
+		t.Errorf("allocs = %v, want 0", got)
+	}
+}
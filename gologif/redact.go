@@ -0,0 +1,118 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/shimt/go-logif"
+)
+
+// DefaultRedactKeys are key names whose values are commonly secret.
+var DefaultRedactKeys = []string{
+	"password",
+	"passwd",
+	"secret",
+	"token",
+	"access_token",
+	"refresh_token",
+	"api_key",
+	"apikey",
+	"authorization",
+	"aws_secret_access_key",
+}
+
+// DefaultRedactPatterns match well-known credential formats.
+// When a pattern has capturing groups, only the first matched group is masked.
+var DefaultRedactPatterns = []*regexp.Regexp{
+	// bearer tokens (Authorization: Bearer xxx)
+	regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]+=*)`),
+	// AWS access key IDs
+	regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+}
+
+// Redactor is a Filter that masks secrets in messages with logif.Redacted.
+//
+// Values of the configured keys are masked when they appear in the forms
+// key=value, key: value and "key": "value" (key names are case-insensitive).
+// An authorization scheme (Basic or Bearer) before the value is kept.
+// Matches of the configured patterns are masked wherever they appear.
+type Redactor struct {
+	key      *regexp.Regexp
+	patterns []*regexp.Regexp
+}
+
+// NewRedactor creates a Redactor masking the values of keys and the
+// matches of patterns.
+func NewRedactor(keys []string, patterns []*regexp.Regexp) *Redactor {
+	r := &Redactor{
+		patterns: append([]*regexp.Regexp(nil), patterns...),
+	}
+
+	if len(keys) > 0 {
+		quoted := make([]string, len(keys))
+		for i, k := range keys {
+			quoted[i] = regexp.QuoteMeta(k)
+		}
+		// a quoted value is masked up to its closing quote, so that the
+		// words after a space are not left in clear.
+		scheme := `(?:(?:basic|bearer)\s+)?`
+		r.key = regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b["']?\s*[:=]\s*` +
+			`(?:"` + scheme + `([^"]*)"|'` + scheme + `([^']*)'|["']?` + scheme + `([^\s"',;&]+))`)
+	}
+
+	return r
+}
+
+// Filter implements Filter.
+func (r *Redactor) Filter(msg []byte) []byte {
+	if r.key != nil {
+		msg = redact(r.key, msg)
+	}
+
+	for _, p := range r.patterns {
+		msg = redact(p, msg)
+	}
+
+	return msg
+}
+
+// redact masks the first matched group of each match of re, or the whole
+// match when no group matched.
+func redact(re *regexp.Regexp, msg []byte) []byte {
+	return replaceMatches(re, msg, func([]byte) []byte {
+		return redacted
+	})
+}
+
+var redacted = []byte(logif.Redacted)
+
+// replaceMatches replaces the first matched group of each match of re, or
+// the whole match when no group matched, with the result of repl.
+// msg is returned as is when re does not match.
+func replaceMatches(re *regexp.Regexp, msg []byte, repl func(match []byte) []byte) []byte {
+	matches := re.FindAllSubmatchIndex(msg, -1)
+	if matches == nil {
+		return msg
+	}
+
+	r := make([]byte, 0, len(msg))
+	last := 0
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		for i := 2; i < len(m); i += 2 {
+			if m[i] >= 0 {
+				start, end = m[i], m[i+1]
+				break
+			}
+		}
+		r = append(r, msg[last:start]...)
+		r = append(r, repl(msg[start:end])...)
+		last = end
+	}
+
+	return append(r, msg[last:]...)
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"os"
+	"strconv"
+	"sync"
+)
+
+// RotatingFile is a log file which is rotated when a write would make it
+// larger than its maximum size: the file is renamed to path.1, path.1 to
+// path.2 and so on, keeping up to maxBackups old files, and a new file is
+// created at path.
+//
+// It may be used simultaneously from multiple goroutines.
+type RotatingFile struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex // protects f and size
+	f    *os.File
+	size int64
+}
+
+// OpenRotatingFile opens the file at path for appending, creating it if
+// needed. A maxSize of 0 or less disables the rotation.
+func OpenRotatingFile(path string, maxSize int64, maxBackups int) (*RotatingFile, error) {
+	r := &RotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *RotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	r.f, r.size = f, fi.Size()
+	return nil
+}
+
+// Write implements io.Writer. p is written to a single file.
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.f == nil {
+		return 0, os.ErrClosed
+	}
+	if r.maxSize > 0 && r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil && r.f == nil {
+			return 0, err
+		}
+	}
+
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+
+	return n, err
+}
+
+// Rotate rotates the file now.
+func (r *RotatingFile) Rotate() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.f == nil {
+		return os.ErrClosed
+	}
+
+	return r.rotate()
+}
+
+func (r *RotatingFile) rotate() error {
+	err := r.f.Close()
+	r.f = nil
+	if err == nil {
+		err = r.shift()
+	}
+
+	// the file is reopened even if it could not be renamed, so that the
+	// following writes are not lost.
+	if oerr := r.open(); err == nil {
+		err = oerr
+	}
+
+	return err
+}
+
+// shift renames the file and its backups to make room for a new file.
+func (r *RotatingFile) shift() error {
+	if r.maxBackups <= 0 {
+		return os.Remove(r.path)
+	}
+
+	for i := r.maxBackups - 1; i > 0; i-- {
+		err := os.Rename(r.backup(i), r.backup(i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return os.Rename(r.path, r.backup(1))
+}
+
+func (r *RotatingFile) backup(i int) string {
+	return r.path + "." + strconv.Itoa(i)
+}
+
+// Close closes the file.
+func (r *RotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.f == nil {
+		return nil
+	}
+
+	err := r.f.Close()
+	r.f = nil
+
+	return err
+}
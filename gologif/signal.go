@@ -0,0 +1,61 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package gologif
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// HandleLevelSignals changes the output level of the logger on signals:
+// SIGUSR1 steps it down toward DEBUG and SIGUSR2 steps it back up toward
+// ERROR. Each change is written to the logger.
+//
+// It returns a function which uninstalls the handlers.
+// The handlers are not installed on platforms without SIGUSR1 and SIGUSR2.
+func (l *Logger) HandleLevelSignals() (stop func()) {
+	c := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(c, syscall.SIGUSR1, syscall.SIGUSR2)
+
+	go func() {
+		for {
+			select {
+			case s := <-c:
+				l.stepLevel(s == syscall.SIGUSR2, s)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(c)
+		close(done)
+	}
+}
+
+// stepLevel moves the output level to the next known level, up or down,
+// and logs the change with its cause.
+func (l *Logger) stepLevel(up bool, cause interface{}) {
+	from := l.OutputLevel()
+	to := nextLevel(from, up)
+	if to == from {
+		return
+	}
+
+	l.setOutputLevel(to, fmt.Sprint(cause))
+	l.Output(2, fmt.Sprintf("gologif: output level changed from %v to %v (%v)", from, to, cause))
+}
+
+// HandleLevelSignals changes the output level of the standard logger on
+// SIGUSR1 and SIGUSR2. See Logger.HandleLevelSignals.
+func HandleLevelSignals() (stop func()) {
+	return std.HandleLevelSignals()
+}
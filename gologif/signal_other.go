@@ -0,0 +1,19 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package gologif
+
+// HandleLevelSignals does nothing on this platform, which has no SIGUSR1
+// and SIGUSR2.
+func (l *Logger) HandleLevelSignals() (stop func()) {
+	return func() {}
+}
+
+// HandleLevelSignals does nothing on this platform, which has no SIGUSR1
+// and SIGUSR2.
+func HandleLevelSignals() (stop func()) {
+	return func() {}
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package gologif
+
+import (
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/shimt/go-logif"
+)
+
+func Test_Logger_HandleLevelSignals(t *testing.T) {
+	b := &syncBuffer{}
+	l := New(b, "", 0)
+	stop := l.HandleLevelSignals()
+	defer stop()
+
+	wait := func(want logif.LogLevel) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for l.OutputLevel() != want {
+			if time.Now().After(deadline) {
+				t.Fatalf("level = %v, want %v", l.OutputLevel(), want)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	p, _ := os.FindProcess(os.Getpid())
+
+	p.Signal(syscall.SIGUSR1)
+	wait(INFO)
+	p.Signal(syscall.SIGUSR1)
+	wait(DEBUG)
+	p.Signal(syscall.SIGUSR2)
+	wait(INFO)
+
+	want := "gologif: output level changed from INFO to DEBUG (user defined signal 1)\n"
+	if got := b.String(); !strings.Contains(got, want) {
+		t.Errorf("got = %q, want %q", got, want)
+	}
+}
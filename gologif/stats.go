@@ -0,0 +1,232 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/shimt/go-logif"
+)
+
+// printLabel is the level label of the records of the Print family.
+const printLabel = "PRINT"
+
+// otherLabel is the level label of records at levels outside DEBUG to ERROR.
+const otherLabel = "OTHER"
+
+// Stats counts the records of the loggers it is attached to.
+//
+// Stats implements expvar.Var, so it can be published with
+//
+//	expvar.Publish("gologif", s)
+//
+// and StatsHandler renders it in the Prometheus text exposition format.
+type Stats struct {
+	emitted    [logif.MAXLEVEL + 2]uint64 // by level; the last one is otherLabel
+	suppressed [logif.MAXLEVEL + 2]uint64
+	printed    uint64
+	bytes      uint64
+	errors     uint64
+	dropped    uint64
+
+	name string
+}
+
+// NewStats creates a Stats. Name is the "logger" label of its metrics.
+func NewStats(name string) *Stats {
+	return &Stats{name: name}
+}
+
+// Name returns the name of s.
+func (s *Stats) Name() string {
+	return s.name
+}
+
+// index returns the index of level in the counters by level.
+func index(level logif.LogLevel) int {
+	for _, l := range levels {
+		if l == level {
+			return int(l)
+		}
+	}
+	return int(logif.MAXLEVEL) + 1
+}
+
+func (s *Stats) emit(level logif.LogLevel, n int, err error) {
+	if level == untagged {
+		atomic.AddUint64(&s.printed, 1)
+	} else {
+		atomic.AddUint64(&s.emitted[index(level)], 1)
+	}
+	atomic.AddUint64(&s.bytes, uint64(n))
+	if err != nil {
+		atomic.AddUint64(&s.errors, 1)
+	}
+}
+
+func (s *Stats) suppress(level logif.LogLevel) {
+	atomic.AddUint64(&s.suppressed[index(level)], 1)
+}
+
+func (s *Stats) drop() {
+	atomic.AddUint64(&s.dropped, 1)
+}
+
+// Emitted returns the number of records written at the level.
+func (s *Stats) Emitted(level logif.LogLevel) uint64 {
+	return atomic.LoadUint64(&s.emitted[index(level)])
+}
+
+// Suppressed returns the number of records not written because the level
+// was disabled.
+func (s *Stats) Suppressed(level logif.LogLevel) uint64 {
+	return atomic.LoadUint64(&s.suppressed[index(level)])
+}
+
+// Printed returns the number of records written by the Print family.
+func (s *Stats) Printed() uint64 {
+	return atomic.LoadUint64(&s.printed)
+}
+
+// Bytes returns the number of bytes written.
+func (s *Stats) Bytes() uint64 {
+	return atomic.LoadUint64(&s.bytes)
+}
+
+// WriteErrors returns the number of writes which failed.
+func (s *Stats) WriteErrors() uint64 {
+	return atomic.LoadUint64(&s.errors)
+}
+
+// Dropped returns the number of records dropped from a full flight recorder.
+func (s *Stats) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// byLevel returns the counters of c by level label.
+func byLevel(c *[logif.MAXLEVEL + 2]uint64) map[string]uint64 {
+	m := map[string]uint64{}
+	for _, l := range levels {
+		m[l.String()] = atomic.LoadUint64(&c[index(l)])
+	}
+	m[otherLabel] = atomic.LoadUint64(&c[len(c)-1])
+
+	return m
+}
+
+// String implements expvar.Var; it returns the counters as a JSON object.
+func (s *Stats) String() string {
+	emitted := byLevel(&s.emitted)
+	emitted[printLabel] = s.Printed()
+
+	b, _ := json.Marshal(struct {
+		Emitted     map[string]uint64 `json:"emitted"`
+		Suppressed  map[string]uint64 `json:"suppressed"`
+		Bytes       uint64            `json:"bytes"`
+		WriteErrors uint64            `json:"write_errors"`
+		Dropped     uint64            `json:"dropped"`
+	}{
+		Emitted:     emitted,
+		Suppressed:  byLevel(&s.suppressed),
+		Bytes:       s.Bytes(),
+		WriteErrors: s.WriteErrors(),
+		Dropped:     s.Dropped(),
+	})
+
+	return string(b)
+}
+
+// SetStats attaches s to the logger; nil detaches it.
+// A Stats can be shared by several loggers.
+func (l *Logger) SetStats(s *Stats) {
+	l.update(func(c *config) { c.stats = s })
+}
+
+// Stats returns the Stats of the logger, or nil.
+func (l *Logger) Stats() *Stats {
+	return l.config().stats
+}
+
+// SetStats attaches s to the standard logger.
+func SetStats(s *Stats) {
+	std.SetStats(s)
+}
+
+// labelEscaper escapes a label value of the Prometheus text format.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// WritePrometheus writes the counters of stats to w in the Prometheus text
+// exposition format.
+func WritePrometheus(w io.Writer, stats ...*Stats) error {
+	b := &bytes.Buffer{}
+
+	family := func(name, help string) {
+		fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+	}
+	sample := func(name string, s *Stats, level string, v uint64) {
+		fmt.Fprintf(b, "%s{logger=\"%s\"", name, labelEscaper.Replace(s.name))
+		if level != "" {
+			fmt.Fprintf(b, ",level=\"%s\"", labelEscaper.Replace(level))
+		}
+		fmt.Fprintf(b, "} %d\n", v)
+	}
+	labels := make([]string, 0, len(levels)+2)
+	for _, l := range levels {
+		labels = append(labels, l.String())
+	}
+	labels = append(labels, otherLabel)
+
+	family("gologif_records_emitted_total", "Records written, by level.")
+	for _, s := range stats {
+		emitted := byLevel(&s.emitted)
+		for _, l := range labels {
+			sample("gologif_records_emitted_total", s, l, emitted[l])
+		}
+		sample("gologif_records_emitted_total", s, printLabel, s.Printed())
+	}
+
+	family("gologif_records_suppressed_total", "Records not written because the level was disabled, by level.")
+	for _, s := range stats {
+		suppressed := byLevel(&s.suppressed)
+		for _, l := range labels {
+			sample("gologif_records_suppressed_total", s, l, suppressed[l])
+		}
+	}
+
+	family("gologif_bytes_written_total", "Bytes written.")
+	for _, s := range stats {
+		sample("gologif_bytes_written_total", s, "", s.Bytes())
+	}
+
+	family("gologif_write_errors_total", "Writes which failed.")
+	for _, s := range stats {
+		sample("gologif_write_errors_total", s, "", s.WriteErrors())
+	}
+
+	family("gologif_records_dropped_total", "Records dropped from a full flight recorder.")
+	for _, s := range stats {
+		sample("gologif_records_dropped_total", s, "", s.Dropped())
+	}
+
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// StatsHandler returns an http.Handler serving the counters of stats in the
+// Prometheus text exposition format.
+func StatsHandler(stats ...*Stats) http.Handler {
+	stats = append([]*Stats(nil), stats...)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		WritePrometheus(w, stats...)
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type failWriter struct{}
+
+func (failWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("fail")
+}
+
+func Test_Logger_SetStats(t *testing.T) {
+	s := NewStats("app")
+	l := New(ioutil.Discard, "", 0)
+	l.SetStats(s)
+	l.SetFlightRecorder(NewFlightRecorder(1, ERROR))
+
+	l.Print("print")
+	l.Debug("debug")
+	l.Debug("debug")
+	l.Info("info")
+	l.Warn("warn")
+	l.Errorf("error %d", 1)
+
+	l.SetFlightRecorder(nil)
+	l.Debug("debug")
+	l.SetOutput(failWriter{})
+	l.Error("error")
+
+	tests := []struct {
+		name string
+		got  uint64
+		want uint64
+	}{
+		{"printed", s.Printed(), 1},
+		{"emitted DEBUG", s.Emitted(DEBUG), 0},
+		{"emitted WARN", s.Emitted(WARN), 1},
+		{"emitted ERROR", s.Emitted(ERROR), 2},
+		{"suppressed DEBUG", s.Suppressed(DEBUG), 3},
+		{"suppressed INFO", s.Suppressed(INFO), 1},
+		{"dropped", s.Dropped(), 2},
+		{"write errors", s.WriteErrors(), 1},
+		{"bytes", s.Bytes(), uint64(len("print\n[WARN] warn\n" + FlightMark + "[INFO] info\n[ERROR] error 1\n[ERROR] error\n"))},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	var v map[string]interface{}
+	if err := json.Unmarshal([]byte(s.String()), &v); err != nil {
+		t.Errorf("String() = %q is not JSON: %v", s.String(), err)
+	}
+}
+
+func Test_StatsHandler(t *testing.T) {
+	s := NewStats(`a"b`)
+	l := New(ioutil.Discard, "", 0)
+	l.SetStats(s)
+	l.Warn("warn")
+
+	w := httptest.NewRecorder()
+	StatsHandler(s, NewStats("other")).ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+
+	got := w.Body.String()
+	for _, want := range []string{
+		"# TYPE gologif_records_emitted_total counter\n",
+		`gologif_records_emitted_total{logger="a\"b",level="WARN"} 1` + "\n",
+		`gologif_records_suppressed_total{logger="other",level="DEBUG"} 0` + "\n",
+		`gologif_bytes_written_total{logger="a\"b"} 12` + "\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("body does not contain %q:\n%s", want, got)
+		}
+	}
+	if n := strings.Count(got, "# TYPE gologif_records_emitted_total"); n != 1 {
+		t.Errorf("TYPE line written %d times", n)
+	}
+}
@@ -5,6 +5,7 @@
 package gologif
 
 import (
+	"fmt"
 	"io"
 	"os"
 
@@ -13,6 +14,11 @@ import (
 
 var std = New(os.Stderr, "", LstdFlags)
 
+// Default returns the standard logger used by the package-level output functions.
+func Default() *Logger {
+	return std
+}
+
 // SetOutput sets the output destination for the standard logger.
 func SetOutput(w io.Writer) {
 	std.SetOutput(w)
@@ -40,61 +46,118 @@ func SetPrefix(prefix string) {
 	std.SetPrefix(prefix)
 }
 
+// SetPrefixFunc sets a function computing the output prefix of each line
+// of the standard logger. See Logger.SetPrefixFunc.
+func SetPrefixFunc(f func() string) {
+	std.SetPrefixFunc(f)
+}
+
 // Print calls Output to print to the standard logger.
 // Arguments are handled in the manner of fmt.Print.
 func Print(v ...interface{}) {
-	std.p(v)
+	level, ok := std.printLevel()
+	if !ok {
+		return
+	}
+
+	std.lp(level, v)
 }
 
 // Printf calls Output to print to the standard logger.
 // Arguments are handled in the manner of fmt.Printf.
 func Printf(format string, v ...interface{}) {
-	std.pf(format, v)
+	level, ok := std.printLevel()
+	if !ok {
+		return
+	}
+
+	std.lpf(level, format, v)
 }
 
 // Println calls Output to print to the standard logger.
 // Arguments are handled in the manner of fmt.Println.
 func Println(v ...interface{}) {
-	std.pl(v)
+	level, ok := std.printLevel()
+	if !ok {
+		return
+	}
+
+	std.lpl(level, v)
 }
 
 // Fatal is equivalent to Print() followed by a call to os.Exit(1).
 func Fatal(v ...interface{}) {
-	std.p(v)
+	std.lp(untagged, v)
 	os.Exit(1)
 }
 
 // Fatalf is equivalent to Printf() followed by a call to os.Exit(1).
 func Fatalf(format string, v ...interface{}) {
-	std.pf(format, v)
+	std.lpf(untagged, format, v)
 	os.Exit(1)
 }
 
 // Fatalln is equivalent to Println() followed by a call to os.Exit(1).
 func Fatalln(v ...interface{}) {
-	std.pl(v)
+	std.lpl(untagged, v)
 	os.Exit(1)
 }
 
 // Panic is equivalent to Print() followed by a call to panic().
 func Panic(v ...interface{}) {
-	panic(std.p(v))
+	s := fmt.Sprint(logif.ResolveLazy(v)...)
+	std.Output(3, s)
+	panic(s)
 }
 
 // Panicf is equivalent to Printf() followed by a call to panic().
 func Panicf(format string, v ...interface{}) {
-	panic(std.pf(format, v))
+	s := fmt.Sprintf(format, logif.ResolveLazy(v)...)
+	std.Output(3, s)
+	panic(s)
 }
 
 // Panicln is equivalent to Println() followed by a call to panic().
 func Panicln(v ...interface{}) {
-	panic(std.pl(v))
+	s := fmt.Sprintln(logif.ResolveLazy(v)...)
+	std.Output(3, s)
+	panic(s)
+}
+
+// Log write message(level=level) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+func Log(level logif.LogLevel, v ...interface{}) {
+	if !std.wants(level) {
+		return
+	}
+
+	std.lp(level, v)
+}
+
+// Logf write message(level=level) to the logger.
+// Arguments are handled in the manner of fmt.Printf.
+func Logf(level logif.LogLevel, format string, v ...interface{}) {
+	if !std.wants(level) {
+		return
+	}
+
+	std.lpf(level, format, v)
+}
+
+// Logln write message(level=level) to the logger.
+// Arguments are handled in the manner of fmt.Println.
+func Logln(level logif.LogLevel, v ...interface{}) {
+	if !std.wants(level) {
+		return
+	}
+
+	std.lpl(level, v)
 }
 
 // Debug write message(level=DEBUG) to the logger.
 // Arguments are handled in the manner of fmt.Print.
 func Debug(v ...interface{}) {
-	if std.OutputLevel() > logif.DEBUG {
+	if !std.wants(logif.DEBUG) {
 		return
 	}
 
@@ -104,7 +167,7 @@ func Debug(v ...interface{}) {
 // Debugf write message(level=DEBUG) to the logger.
 // Arguments are handled in the manner of fmt.Printf.
 func Debugf(format string, v ...interface{}) {
-	if std.OutputLevel() > logif.DEBUG {
+	if !std.wants(logif.DEBUG) {
 		return
 	}
 
@@ -114,7 +177,7 @@ func Debugf(format string, v ...interface{}) {
 // Debugln write message(level=DEBUG) to the logger.
 // Arguments are handled in the manner of fmt.Println.
 func Debugln(v ...interface{}) {
-	if std.OutputLevel() > logif.DEBUG {
+	if !std.wants(logif.DEBUG) {
 		return
 	}
 
@@ -124,7 +187,7 @@ func Debugln(v ...interface{}) {
 // Info write message(level=INFO) to the logger.
 // Arguments are handled in the manner of fmt.Print.
 func Info(v ...interface{}) {
-	if std.OutputLevel() > logif.INFO {
+	if !std.wants(logif.INFO) {
 		return
 	}
 
@@ -134,7 +197,7 @@ func Info(v ...interface{}) {
 // Infof write message(level=INFO) to the logger.
 // Arguments are handled in the manner of fmt.Printf.
 func Infof(format string, v ...interface{}) {
-	if std.OutputLevel() > logif.INFO {
+	if !std.wants(logif.INFO) {
 		return
 	}
 
@@ -144,7 +207,7 @@ func Infof(format string, v ...interface{}) {
 // Infoln write message(level=INFO) to the logger.
 // Arguments are handled in the manner of fmt.Println.
 func Infoln(v ...interface{}) {
-	if std.OutputLevel() > logif.INFO {
+	if !std.wants(logif.INFO) {
 		return
 	}
 
@@ -154,7 +217,7 @@ func Infoln(v ...interface{}) {
 // Warn write message(level=WARN) to the logger.
 // Arguments are handled in the manner of fmt.Print.
 func Warn(v ...interface{}) {
-	if std.OutputLevel() > logif.WARN {
+	if !std.wants(logif.WARN) {
 		return
 	}
 
@@ -164,7 +227,7 @@ func Warn(v ...interface{}) {
 // Warnf write message(level=WARN) to the logger.
 // Arguments are handled in the manner of fmt.Printf.
 func Warnf(format string, v ...interface{}) {
-	if std.OutputLevel() > logif.WARN {
+	if !std.wants(logif.WARN) {
 		return
 	}
 
@@ -174,7 +237,7 @@ func Warnf(format string, v ...interface{}) {
 // Warnln write message(level=WARN) to the logger.
 // Arguments are handled in the manner of fmt.Println.
 func Warnln(v ...interface{}) {
-	if std.OutputLevel() > logif.WARN {
+	if !std.wants(logif.WARN) {
 		return
 	}
 
@@ -184,7 +247,7 @@ func Warnln(v ...interface{}) {
 // Error write message(level=ERROR) to the logger.
 // Arguments are handled in the manner of fmt.Print.
 func Error(v ...interface{}) {
-	if std.OutputLevel() > logif.ERROR {
+	if !std.wants(logif.ERROR) {
 		return
 	}
 
@@ -194,7 +257,7 @@ func Error(v ...interface{}) {
 // Errorf write message(level=ERROR) to the logger.
 // Arguments are handled in the manner of fmt.Printf.
 func Errorf(format string, v ...interface{}) {
-	if std.OutputLevel() > logif.ERROR {
+	if !std.wants(logif.ERROR) {
 		return
 	}
 
@@ -204,19 +267,47 @@ func Errorf(format string, v ...interface{}) {
 // Errorln write message(level=ERROR) to the logger.
 // Arguments are handled in the manner of fmt.Println.
 func Errorln(v ...interface{}) {
-	if std.OutputLevel() > logif.ERROR {
+	if !std.wants(logif.ERROR) {
 		return
 	}
 
 	std.lpl(logif.ERROR, v)
 }
 
+// Enabled reports whether a message of the level is written to the standard logger.
+func Enabled(level logif.LogLevel) bool {
+	return std.Enabled(level)
+}
+
+// SetPrintLevel sets the level of the Print family of the standard logger.
+// See Logger.SetPrintLevel.
+func SetPrintLevel(level logif.LogLevel) {
+	std.SetPrintLevel(level)
+}
+
+// PrintLevel returns the level of the Print family of the standard logger,
+// or Untagged.
+func PrintLevel() logif.LogLevel {
+	return std.PrintLevel()
+}
+
 // SetOutputLevel set output level
 func SetOutputLevel(l logif.LogLevel) {
-	std.SetOutputLevel(l)
+	std.setOutputLevel(l, callerName(1))
 }
 
 // OutputLevel set output level
 func OutputLevel() logif.LogLevel {
 	return std.OutputLevel()
 }
+
+// SetAtomicLevel makes the standard logger use a as its output level.
+// See Logger.SetAtomicLevel.
+func SetAtomicLevel(a *logif.AtomicLevel) {
+	std.SetAtomicLevel(a)
+}
+
+// AtomicLevel returns the output level of the standard logger.
+func AtomicLevel() *logif.AtomicLevel {
+	return std.AtomicLevel()
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/shimt/go-logif"
+)
+
+var (
+	// stdFileLine matches the file name and line number written by the
+	// standard logger.
+	stdFileLine = regexp.MustCompile(`^[^\n]*?:\d+: `)
+	// stdLevelTag matches a level tag such as "[WARN] ".
+	stdLevelTag = regexp.MustCompile(`(?i)^\[(debug|info|warn|warning|error)\] ?`)
+	// stdLevelWord matches a level word such as "error:".
+	stdLevelWord = regexp.MustCompile(`(?i)^(debug|info|warn|warning|error):`)
+)
+
+// stdLogWriter is the output of the standard logger redirected to a Logger.
+type stdLogWriter struct {
+	logger *Logger
+	level  logif.LogLevel
+	detect bool
+	source func() (prefix string, flags int) // of the *log.Logger writing to w
+}
+
+// Write implements io.Writer; p is one line written by the standard logger.
+func (w *stdLogWriter) Write(p []byte) (int, error) {
+	prefix, flags := w.source()
+	msg, prefixed := stdMessage(p, prefix, flags)
+
+	level := w.level
+	if w.detect {
+		level, msg = detectLevel(msg, level)
+	}
+
+	if !w.logger.wants(level) {
+		return len(p), nil
+	}
+
+	c := w.logger.config()
+
+	var file string
+	var line int
+	if c.flag&(Lshortfile|Llongfile) != 0 {
+		file, line = callerOutside(1, "log")
+	}
+
+	b := c.begin(level, file, line)
+	if prefixed {
+		b.WriteString(prefix)
+	}
+	b.Write(msg)
+	w.logger.lwrite(level, b)
+
+	return len(p), nil
+}
+
+// stdMessage returns the message of a line written by a *log.Logger with
+// prefix and flags, without the prefix, the date and time and the file name
+// and line number. prefixed reports whether the line had the prefix, which
+// is kept before the message.
+func stdMessage(line []byte, prefix string, flags int) (msg []byte, prefixed bool) {
+	msg = line
+	if prefix != "" && flags&msgprefix == 0 && bytes.HasPrefix(msg, []byte(prefix)) {
+		msg, prefixed = msg[len(prefix):], true
+	}
+	if n := stdTimestampLen(flags); len(msg) >= n {
+		msg = msg[n:]
+	}
+	if flags&(log.Lshortfile|log.Llongfile) != 0 {
+		msg = msg[len(stdFileLine.Find(msg)):]
+	}
+	if prefix != "" && flags&msgprefix != 0 && bytes.HasPrefix(msg, []byte(prefix)) {
+		msg, prefixed = msg[len(prefix):], true
+	}
+
+	return msg, prefixed
+}
+
+// stdTimestampLen returns the length of the date and time written by a
+// *log.Logger with flags.
+func stdTimestampLen(flags int) int {
+	n := 0
+	if flags&log.Ldate != 0 {
+		n += len("2006/01/02 ")
+	}
+	if flags&(log.Ltime|log.Lmicroseconds) != 0 {
+		n += len("15:04:05 ")
+		if flags&log.Lmicroseconds != 0 {
+			n += len(".000000")
+		}
+	}
+
+	return n
+}
+
+// detectLevel infers the level of msg from a leading "[WARN]" style tag,
+// which is removed, or a leading "error:" style word. It returns level
+// when msg has neither.
+func detectLevel(msg []byte, level logif.LogLevel) (logif.LogLevel, []byte) {
+	if m := stdLevelTag.FindSubmatch(msg); m != nil {
+		return parseStdLevel(string(m[1])), msg[len(m[0]):]
+	}
+	if m := stdLevelWord.FindSubmatch(msg); m != nil {
+		return parseStdLevel(string(m[1])), msg
+	}
+
+	return level, msg
+}
+
+func parseStdLevel(s string) logif.LogLevel {
+	if strings.EqualFold(s, "warning") {
+		return logif.WARN
+	}
+
+	l, _ := logif.ParseLogLevel(s)
+	return l
+}
+
+// RedirectStdLog redirects the output of the standard library logger
+// (log.Print and so on) to the logger, as records at level.
+//
+// The date and time, and the file name and line number, added by the
+// standard logger are removed, as the logger adds its own; a prefix set on
+// the standard logger is kept before the message. When detect is true, a record starting with a level
+// tag such as "[WARN]" or a level word such as "error:" is written at that
+// level instead.
+//
+// It returns a function which restores the output and the flags of the
+// standard library logger.
+func (l *Logger) RedirectStdLog(level logif.LogLevel, detect bool) (restore func()) {
+	flags, out := log.Flags(), log.Writer()
+
+	log.SetFlags(0)
+	log.SetOutput(&stdLogWriter{
+		logger: l,
+		level:  level,
+		detect: detect,
+		source: func() (string, int) { return log.Prefix(), log.Flags() },
+	})
+
+	return func() {
+		log.SetFlags(flags)
+		log.SetOutput(out)
+	}
+}
+
+// RedirectStdLog redirects the output of the standard library logger to the
+// standard logger. See Logger.RedirectStdLog.
+func RedirectStdLog(level logif.LogLevel, detect bool) (restore func()) {
+	return std.RedirectStdLog(level, detect)
+}
+
+// StdLogger returns a *log.Logger which writes to the logger as records at
+// level, for APIs such as http.Server.ErrorLog which require one.
+//
+// The records are subject to the output level of the logger, and the file
+// and line number point to the caller of the *log.Logger. The date and
+// time and the file name and line number, if the flags of the returned
+// logger are changed to add them, are removed as the logger adds its own;
+// its prefix is kept before the message.
+func (l *Logger) StdLogger(level logif.LogLevel) *log.Logger {
+	w := &stdLogWriter{
+		logger: l,
+		level:  level,
+	}
+	sl := log.New(w, "", 0)
+	w.source = func() (string, int) { return sl.Prefix(), sl.Flags() }
+
+	return sl
+}
+
+// StdLogger returns a *log.Logger which writes to the standard logger as
+// records at level. See Logger.StdLogger.
+func StdLogger(level logif.LogLevel) *log.Logger {
+	return std.StdLogger(level)
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func Test_Logger_RedirectStdLog(t *testing.T) {
+	tests := []struct {
+		name   string
+		detect bool
+		flags  int
+		prefix string
+		msg    string
+		want   string
+	}{
+		{"plain", false, LstdFlags, "", "message", "[INFO] message\n"},
+		{"timestamp", false, LstdFlags | Lmicroseconds, "", "message", "[INFO] message\n"},
+		{"date", false, Ldate, "", "message", "[INFO] message\n"},
+		{"time-in-message", false, 0, "", "12:34:56 started", "[INFO] 12:34:56 started\n"},
+		{"time-after-timestamp", false, Ltime, "", "12:34:56 started", "[INFO] 12:34:56 started\n"},
+		{"prefix", false, LstdFlags, "lib: ", "message", "[INFO] lib: message\n"},
+		{"prefix-file", false, LstdFlags | Llongfile, "lib: ", "message", "[INFO] lib: message\n"},
+		{"msgprefix", false, LstdFlags | Lshortfile | Lmsgprefix, "lib: ", "message", "[INFO] lib: message\n"},
+		{"no-detect", false, 0, "", "[WARN] message", "[INFO] [WARN] message\n"},
+		{"tag", true, 0, "", "[WARN] message", "[WARN] message\n"},
+		{"prefix-tag", true, 0, "lib: ", "[WARN] message", "[WARN] lib: message\n"},
+		{"warning", true, 0, "", "[Warning] message", "[WARN] message\n"},
+		{"word", true, 0, "", "error: message", "[ERROR] error: message\n"},
+		{"debug", true, 0, "", "[DEBUG] message", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			l := New(b, "", 0)
+			l.SetOutputLevel(INFO)
+
+			restore := l.RedirectStdLog(INFO, tt.detect)
+			defer restore()
+
+			// a library resetting the flags of the standard logger.
+			log.SetFlags(tt.flags)
+			log.SetPrefix(tt.prefix)
+			defer log.SetPrefix("")
+			log.Print(tt.msg)
+
+			if got := b.String(); got != tt.want {
+				t.Errorf("got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Logger_RedirectStdLog_restore(t *testing.T) {
+	out, flags := log.Writer(), log.Flags()
+
+	restore := New(&bytes.Buffer{}, "", 0).RedirectStdLog(INFO, false)
+	if log.Flags() != 0 {
+		t.Errorf("flags = %v, want 0", log.Flags())
+	}
+	restore()
+
+	if log.Writer() != out || log.Flags() != flags {
+		t.Errorf("standard logger not restored")
+	}
+}
+
+func Test_Logger_RedirectStdLog_calldepth(t *testing.T) {
+	want := "stdlog_test.go:"
+	b := &bytes.Buffer{}
+	l := New(b, "", Lshortfile)
+
+	defer l.RedirectStdLog(WARN, false)()
+	log.Printf("test")
+
+	if got := b.String(); !bytes.HasPrefix(b.Bytes(), []byte(want)) {
+		t.Errorf("got = %v, want prefix %v", got, want)
+	}
+}
+
+func Test_Logger_StdLogger(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := New(b, "", Lshortfile)
+	l.SetOutputLevel(INFO)
+
+	sl := l.StdLogger(INFO)
+	_, _, line, _ := runtime.Caller(0)
+	sl.Printf("test")
+
+	want := fmt.Sprintf("stdlog_test.go:%d: [INFO] test\n", line+1)
+	if got := b.String(); got != want {
+		t.Errorf("got = %q, want %q", got, want)
+	}
+
+	// the date and time are removed only when the flags add them.
+	l.SetFlags(0)
+	b.Reset()
+	sl.Print("12:34:56 started")
+	sl.SetFlags(LstdFlags)
+	sl.Print("12:34:56 started")
+	if want := "[INFO] 12:34:56 started\n[INFO] 12:34:56 started\n"; b.String() != want {
+		t.Errorf("got = %q, want %q", b.String(), want)
+	}
+
+	// the prefix is kept, as the http.Server.ErrorLog setup does.
+	b.Reset()
+	sl.SetPrefix("http: ")
+	sl.Print("TLS handshake error")
+	sl.SetFlags(LstdFlags | Lshortfile)
+	sl.Print("TLS handshake error")
+	sl.SetFlags(LstdFlags | Lmsgprefix)
+	sl.Print("TLS handshake error")
+	if want := strings.Repeat("[INFO] http: TLS handshake error\n", 3); b.String() != want {
+		t.Errorf("got = %q, want %q", b.String(), want)
+	}
+
+	b.Reset()
+	l.StdLogger(DEBUG).Print("suppressed")
+	if b.Len() != 0 {
+		t.Errorf("got = %q, want no output below INFO", b.String())
+	}
+}
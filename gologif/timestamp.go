@@ -0,0 +1,116 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"strconv"
+	"time"
+)
+
+// Pseudo layouts for SetTimeLayout writing the time as a number since the
+// Unix epoch.
+const (
+	TimeUnix      = "unix"
+	TimeUnixMilli = "unixmilli"
+	TimeUnixMicro = "unixmicro"
+	TimeUnixNano  = "unixnano"
+)
+
+// appendTime appends t formatted with layout, which is a layout of the time
+// package or one of the TimeUnix pseudo layouts.
+func appendTime(b *buffer, t time.Time, layout string) {
+	switch layout {
+	case TimeUnix:
+		b.b = strconv.AppendInt(b.b, t.Unix(), 10)
+	case TimeUnixMilli:
+		b.b = strconv.AppendInt(b.b, t.UnixNano()/int64(time.Millisecond), 10)
+	case TimeUnixMicro:
+		b.b = strconv.AppendInt(b.b, t.UnixNano()/int64(time.Microsecond), 10)
+	case TimeUnixNano:
+		b.b = strconv.AppendInt(b.b, t.UnixNano(), 10)
+	default:
+		b.b = t.AppendFormat(b.b, layout)
+	}
+}
+
+// now returns the current time of the clock in the location of the logger.
+func (c *config) now() time.Time {
+	var t time.Time
+	if c.clock != nil {
+		t = c.clock()
+	} else {
+		t = time.Now()
+	}
+
+	switch {
+	case c.location != nil:
+		t = t.In(c.location)
+	case c.flag&LUTC != 0:
+		t = t.UTC()
+	}
+
+	return t
+}
+
+// timed reports whether the header of the logger has a timestamp.
+func (c *config) timed() bool {
+	return c.timeLayout != "" || c.flag&(Ldate|Ltime|Lmicroseconds) != 0
+}
+
+// SetTimeLayout sets the layout of the timestamp for the logger.
+// Layout is a layout of the time package, e.g. time.RFC3339Nano, or one of
+// TimeUnix, TimeUnixMilli, TimeUnixMicro and TimeUnixNano.
+// A non-empty layout replaces the date and time selected by Ldate, Ltime
+// and Lmicroseconds; an empty one restores them.
+func (l *Logger) SetTimeLayout(layout string) {
+	l.update(func(c *config) { c.timeLayout = layout })
+}
+
+// TimeLayout returns the layout of the timestamp for the logger.
+func (l *Logger) TimeLayout() string {
+	return l.config().timeLayout
+}
+
+// SetLocation sets the location of the timestamp for the logger.
+// A nil location is the local time zone, or UTC with LUTC.
+func (l *Logger) SetLocation(loc *time.Location) {
+	l.update(func(c *config) { c.location = loc })
+}
+
+// Location returns the location of the timestamp for the logger, or nil.
+func (l *Logger) Location() *time.Location {
+	return l.config().location
+}
+
+// SetClock sets the function returning the current time for the logger.
+// A nil clock is time.Now.
+func (l *Logger) SetClock(clock func() time.Time) {
+	l.update(func(c *config) { c.clock = clock })
+}
+
+// SetTimeLayout sets the layout of the timestamp for the standard logger.
+func SetTimeLayout(layout string) {
+	std.SetTimeLayout(layout)
+}
+
+// TimeLayout returns the layout of the timestamp for the standard logger.
+func TimeLayout() string {
+	return std.TimeLayout()
+}
+
+// SetLocation sets the location of the timestamp for the standard logger.
+func SetLocation(loc *time.Location) {
+	std.SetLocation(loc)
+}
+
+// Location returns the location of the timestamp for the standard logger.
+func Location() *time.Location {
+	return std.Location()
+}
+
+// SetClock sets the function returning the current time for the standard logger.
+func SetClock(clock func() time.Time) {
+	std.SetClock(clock)
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func Test_Logger_SetTimeLayout(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	now := time.Date(2020, 3, 22, 5, 6, 21, 123456789, time.UTC)
+
+	tests := []struct {
+		name     string
+		flag     int
+		layout   string
+		location *time.Location
+		want     string
+	}{
+		{"flags", LstdFlags | Lmicroseconds | LUTC, "", nil, "2020/03/22 05:06:21.123456 [WARN] message\n"},
+		{"flags-location", LstdFlags, "", tokyo, "2020/03/22 14:06:21 [WARN] message\n"},
+		{"rfc3339nano", 0, time.RFC3339Nano, time.UTC, "2020-03-22T05:06:21.123456789Z [WARN] message\n"},
+		{"iso8601-offset", LUTC, "2006-01-02T15:04:05.000-07:00", tokyo, "2020-03-22T14:06:21.123+09:00 [WARN] message\n"},
+		{"unix", 0, TimeUnix, nil, "1584853581 [WARN] message\n"},
+		{"unixmilli", 0, TimeUnixMilli, nil, "1584853581123 [WARN] message\n"},
+		{"unixnano", Ldate, TimeUnixNano, nil, "1584853581123456789 [WARN] message\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			l := New(b, "", tt.flag)
+			l.SetClock(func() time.Time { return now })
+			l.SetTimeLayout(tt.layout)
+			l.SetLocation(tt.location)
+
+			l.Warn("message")
+
+			if got := b.String(); got != tt.want {
+				t.Errorf("got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Logger_SetClock(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := New(b, "", LstdFlags|LUTC)
+	l.SetOutputLevel(DEBUG)
+
+	tick := time.Date(2020, 3, 22, 0, 0, 0, 0, time.UTC)
+	l.SetClock(func() time.Time {
+		tick = tick.Add(time.Second)
+		return tick
+	})
+
+	l.Debug("one")
+	l.Print("two")
+
+	if want := "2020/03/22 00:00:01 [DEBUG] one\n2020/03/22 00:00:02 two\n"; b.String() != want {
+		t.Errorf("got = %q, want %q", b.String(), want)
+	}
+}
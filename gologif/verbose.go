@@ -0,0 +1,265 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/shimt/go-logif"
+)
+
+// Verbose writes records of a verbosity level, in the manner of glog:
+//
+//	if v := l.V(2); v.Enabled() {
+//		v.Infof("state: %v", dump(s))
+//	}
+//	l.V(3).Info("details")
+//
+// Verbosity levels are layered under DEBUG: V(n) is enabled when the
+// verbosity of the caller is at least n and DEBUG records are written, or
+// kept by a flight recorder, and its records are written at DEBUG. The
+// zero Verbose is disabled.
+type Verbose struct {
+	l *Logger
+}
+
+// Enabled reports whether the records of v are written or recorded.
+func (v Verbose) Enabled() bool {
+	return v.l != nil
+}
+
+// Info write message(level=DEBUG) to the logger if v is enabled.
+// Arguments are handled in the manner of fmt.Print.
+func (v Verbose) Info(args ...interface{}) {
+	if v.l == nil {
+		return
+	}
+
+	v.l.lp(logif.DEBUG, args)
+}
+
+// Infof write message(level=DEBUG) to the logger if v is enabled.
+// Arguments are handled in the manner of fmt.Printf.
+func (v Verbose) Infof(format string, args ...interface{}) {
+	if v.l == nil {
+		return
+	}
+
+	v.l.lpf(logif.DEBUG, format, args)
+}
+
+// Infoln write message(level=DEBUG) to the logger if v is enabled.
+// Arguments are handled in the manner of fmt.Println.
+func (v Verbose) Infoln(args ...interface{}) {
+	if v.l == nil {
+		return
+	}
+
+	v.l.lpl(logif.DEBUG, args)
+}
+
+// V returns a Verbose enabled when the verbosity of the caller is at least
+// level and DEBUG records are written or recorded. The verbosity is that set by SetVModule for
+// the source file of the caller, or else that set by SetVerbosity.
+func (l *Logger) V(level int) Verbose {
+	return l.v(level, 1)
+}
+
+// v is V for the caller skip frames above the caller of v.
+func (l *Logger) v(level int, skip int) Verbose {
+	c := l.config()
+	if !c.level.Enabled(logif.DEBUG) && c.recorder == nil && c.stats == nil {
+		// the fast path of wants, without the caller lookup.
+		return Verbose{}
+	}
+
+	verbosity := c.verbosity
+	if c.vmodule != nil {
+		var pcs [1]uintptr
+		if runtime.Callers(skip+2, pcs[:]) == 1 {
+			if v, ok := c.vmodule.lookup(pcs[0]); ok {
+				verbosity = v
+			}
+		}
+	}
+	if level > verbosity || !l.wants(logif.DEBUG) {
+		return Verbose{}
+	}
+
+	return Verbose{l: l}
+}
+
+// SetVerbosity sets the verbosity of the logger, 0 by default.
+func (l *Logger) SetVerbosity(v int) {
+	l.update(func(c *config) { c.verbosity = v })
+}
+
+// Verbosity returns the verbosity of the logger.
+func (l *Logger) Verbosity() int {
+	return l.config().verbosity
+}
+
+// SetVModule sets the verbosity of source files, overriding that set by
+// SetVerbosity, as the -vmodule flag of glog does. spec is a list of
+// pattern=N separated by commas, such as "server=2,db_*=3,cache/*=1".
+// A pattern is matched with filepath.Match against the path of the file
+// without ".go": its last element, or as many last elements as the pattern
+// has, or the full path if the pattern starts with a slash. The first
+// matching pattern applies. An empty spec removes the overrides.
+//
+// The verbosity of each call site of V is computed once and cached.
+func (l *Logger) SetVModule(spec string) error {
+	vm, err := parseVModule(spec)
+	if err != nil {
+		return err
+	}
+
+	l.update(func(c *config) { c.vmodule = vm })
+	return nil
+}
+
+// VModule returns the spec set by SetVModule.
+func (l *Logger) VModule() string {
+	if vm := l.config().vmodule; vm != nil {
+		return vm.spec
+	}
+	return ""
+}
+
+// vmodule holds the verbosity of source files, and caches the verbosity of
+// the call sites of V.
+type vmodule struct {
+	spec     string
+	patterns []vpattern
+
+	mu    sync.Mutex   // serializes updates of cache
+	cache atomic.Value // map[uintptr]int; verbosity of a PC, -1 if none
+}
+
+type vpattern struct {
+	pattern   string
+	elems     int // number of path elements matched, 0 for the full path
+	verbosity int
+}
+
+func parseVModule(spec string) (*vmodule, error) {
+	if spec == "" {
+		return nil, nil
+	}
+
+	vm := &vmodule{spec: spec}
+	for _, s := range strings.Split(spec, ",") {
+		i := strings.LastIndexByte(s, '=')
+		if i <= 0 {
+			return nil, fmt.Errorf("gologif: invalid vmodule %q: no pattern=N in %q", spec, s)
+		}
+
+		pattern := strings.TrimSpace(s[:i])
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("gologif: invalid vmodule %q: %v", spec, err)
+		}
+		v, err := strconv.Atoi(strings.TrimSpace(s[i+1:]))
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("gologif: invalid vmodule %q: bad verbosity in %q", spec, s)
+		}
+
+		elems := strings.Count(pattern, "/") + 1
+		if strings.HasPrefix(pattern, "/") {
+			elems = 0
+		}
+
+		vm.patterns = append(vm.patterns, vpattern{
+			pattern:   pattern,
+			elems:     elems,
+			verbosity: v,
+		})
+	}
+	vm.cache.Store(map[uintptr]int{})
+
+	return vm, nil
+}
+
+// lookup returns the verbosity of the source file of pc, if a pattern
+// matches it.
+func (vm *vmodule) lookup(pc uintptr) (int, bool) {
+	v, ok := vm.cache.Load().(map[uintptr]int)[pc]
+	if !ok {
+		v = vm.resolve(pc)
+	}
+
+	return v, v >= 0
+}
+
+// resolve computes the verbosity of pc and caches it.
+func (vm *vmodule) resolve(pc uintptr) int {
+	f, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	v := vm.match(f.File)
+
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+
+	old := vm.cache.Load().(map[uintptr]int)
+	cache := make(map[uintptr]int, len(old)+1)
+	for k, v := range old {
+		cache[k] = v
+	}
+	cache[pc] = v
+	vm.cache.Store(cache)
+
+	return v
+}
+
+// match returns the verbosity of the first pattern matching file, or -1.
+func (vm *vmodule) match(file string) int {
+	file = strings.TrimSuffix(file, ".go")
+
+	for _, p := range vm.patterns {
+		if ok, _ := filepath.Match(p.pattern, lastElems(file, p.elems)); ok {
+			return p.verbosity
+		}
+	}
+
+	return -1
+}
+
+// lastElems returns the last n elements of path, or path if n is 0 or
+// path has fewer elements.
+func lastElems(path string, n int) string {
+	if n == 0 {
+		return path
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		if path[i] == '/' {
+			if n--; n == 0 {
+				return path[i+1:]
+			}
+		}
+	}
+
+	return path
+}
+
+// V returns a Verbose for the standard logger. See Logger.V.
+func V(level int) Verbose {
+	return std.v(level, 1)
+}
+
+// SetVerbosity sets the verbosity of the standard logger.
+func SetVerbosity(v int) {
+	std.SetVerbosity(v)
+}
+
+// SetVModule sets the verbosity of source files for the standard logger.
+// See Logger.SetVModule.
+func SetVModule(spec string) error {
+	return std.SetVModule(spec)
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gologif
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/shimt/go-logif"
+)
+
+func Test_Logger_V(t *testing.T) {
+	tests := []struct {
+		name        string
+		outputLevel logif.LogLevel
+		verbosity   int
+		vmodule     string
+		want        string
+	}{
+		{"verbosity 1", DEBUG, 1, "", "[DEBUG] v1\n"},
+		{"enabled", DEBUG, 2, "", "[DEBUG] v1\n[DEBUG] v2\n"},
+		{"not DEBUG", INFO, 2, "", ""},
+		{"vmodule", DEBUG, 0, "verbose_test=2", "[DEBUG] v1\n[DEBUG] v2\n"},
+		{"vmodule glob", DEBUG, 3, "verb*=1,verbose_test=2", "[DEBUG] v1\n"},
+		{"vmodule path", DEBUG, 0, "gologif/verbose_*=1", "[DEBUG] v1\n"},
+		{"vmodule other", DEBUG, 1, "other=3", "[DEBUG] v1\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			l := New(b, "", 0)
+			l.SetOutputLevel(tt.outputLevel)
+			l.SetVerbosity(tt.verbosity)
+			if err := l.SetVModule(tt.vmodule); err != nil {
+				t.Fatal(err)
+			}
+
+			// twice to use the cached verbosity.
+			for i := 0; i < 2; i++ {
+				b.Reset()
+				l.V(1).Info("v1")
+				if v := l.V(2); v.Enabled() {
+					v.Infof("v%d", 2)
+				}
+				l.V(3).Infoln("v3")
+
+				if got := b.String(); got != tt.want {
+					t.Errorf("got = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func Test_Logger_V_flightRecorder(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := New(b, "", 0)
+	l.SetVerbosity(1)
+	l.SetFlightRecorder(NewFlightRecorder(10, ERROR))
+
+	// below the output level, V records are kept as other DEBUG records.
+	if v := l.V(1); !v.Enabled() {
+		t.Fatalf("V(1) disabled with a flight recorder")
+	}
+	l.V(1).Info("v1")
+	l.V(2).Info("v2")
+	if b.Len() != 0 {
+		t.Errorf("got = %q, want no output before the trigger", b.String())
+	}
+
+	l.Error("failed")
+	if want := FlightMark + "[DEBUG] v1\n[ERROR] failed\n"; b.String() != want {
+		t.Errorf("got = %q, want %q", b.String(), want)
+	}
+}
+
+func Test_parseVModule(t *testing.T) {
+	for _, spec := range []string{"server", "=1", "server=x", "server=-1", "[=1"} {
+		if _, err := parseVModule(spec); err == nil {
+			t.Errorf("parseVModule(%q) succeeded", spec)
+		}
+	}
+}
+
+func Test_Logger_V_allocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations are not counted reliably with the race detector")
+	}
+
+	l := New(&bytes.Buffer{}, "", 0)
+	l.SetOutputLevel(DEBUG)
+	l.SetVModule("other=3")
+
+	if got := testing.AllocsPerRun(100, func() { l.V(2).Info() }); got > 0 {
+		t.Errorf("allocs = %v, want 0", got)
+	}
+}
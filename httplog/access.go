@@ -0,0 +1,213 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package httplog is net/http integration of logif.
+package httplog
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/shimt/go-logif"
+)
+
+// Format is the layout of an access log line.
+type Format int
+
+const (
+	// Default method, escaped path, status, bytes, duration, remote address and user agent.
+	//
+	//	GET /index.html 200 2326 1.52ms 127.0.0.1:52814 "curl/7.68.0"
+	Default Format = iota
+	// Common Apache Common Log Format.
+	//
+	//	127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /index.html HTTP/1.1" 200 2326
+	Common
+	// Combined Apache Combined Log Format.
+	//
+	//	127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /index.html HTTP/1.1" 200 2326 "http://example.com/" "curl/7.68.0"
+	Combined
+)
+
+// clfTimeLayout is the time layout of the Apache log formats.
+const clfTimeLayout = "02/Jan/2006:15:04:05 -0700"
+
+type accessLog struct {
+	logger logif.LeveledLogger
+	format Format
+	next   http.Handler
+}
+
+// NewAccessLog returns a handler that serves requests with next and writes
+// an access log line for each of them to l.
+//
+// The level of the line is chosen by the status class:
+// ERROR for 5xx, WARN for 4xx and INFO for the others.
+func NewAccessLog(l logif.LeveledLogger, format Format, next http.Handler) http.Handler {
+	return &accessLog{
+		logger: l,
+		format: format,
+		next:   next,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+//
+// The line is written even when next panics, with status 500 unless a
+// status was already written, and the panic is then left to propagate.
+// A hijacked connection is logged with status 101 unless a status was
+// written before it was hijacked.
+func (a *accessLog) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	rw := &responseWriter{ResponseWriter: w}
+
+	served := false
+	defer func() {
+		status := rw.status
+		switch {
+		case status != 0:
+		case !served:
+			status = http.StatusInternalServerError
+		case rw.hijacked:
+			status = http.StatusSwitchingProtocols
+		default:
+			status = http.StatusOK
+		}
+
+		a.log(r, start, status, rw.bytes)
+	}()
+
+	a.next.ServeHTTP(rw, r)
+	served = true
+}
+
+// log writes the access log line of r.
+func (a *accessLog) log(r *http.Request, start time.Time, status int, bytes int64) {
+	level := statusLevel(status)
+	if !a.logger.Enabled(level) {
+		return
+	}
+
+	var line string
+	switch a.format {
+	case Common:
+		line = common(r, start, status, bytes)
+	case Combined:
+		line = common(r, start, status, bytes) + " " + quote(r.Referer()) + " " + quote(r.UserAgent())
+	default:
+		line = fmt.Sprintf("%s %s %d %d %v %s %s", r.Method, r.URL.EscapedPath(), status, bytes, time.Since(start), r.RemoteAddr, quote(r.UserAgent()))
+	}
+
+	a.logger.Log(level, line)
+}
+
+// statusLevel returns the log level for the HTTP status code.
+func statusLevel(status int) logif.LogLevel {
+	switch {
+	case status >= 500:
+		return logif.ERROR
+	case status >= 400:
+		return logif.WARN
+	default:
+		return logif.INFO
+	}
+}
+
+// common formats the request in Apache Common Log Format.
+func common(r *http.Request, start time.Time, status int, bytes int64) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	user := "-"
+	if r.URL.User != nil && r.URL.User.Username() != "" {
+		user = r.URL.User.Username()
+	} else if u, _, ok := r.BasicAuth(); ok && u != "" {
+		user = u
+	}
+
+	size := "-"
+	if bytes > 0 {
+		size = strconv.FormatInt(bytes, 10)
+	}
+
+	return fmt.Sprintf("%s - %s [%s] \"%s %s %s\" %d %s",
+		host, user, start.Format(clfTimeLayout), r.Method, r.RequestURI, r.Proto, status, size)
+}
+
+// quote returns s in double quotes, or "-" when s is empty.
+func quote(s string) string {
+	if s == "" {
+		return `"-"`
+	}
+	return strconv.Quote(s)
+}
+
+// responseWriter records the status code and the body size of a response,
+// and whether its connection was hijacked.
+type responseWriter struct {
+	http.ResponseWriter
+	status   int
+	bytes    int64
+	hijacked bool
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *responseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write implements http.ResponseWriter.
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += int64(n)
+	return n, err
+}
+
+// Flush implements http.Flusher.
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker.
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("httplog: response does not implement http.Hijacker")
+	}
+
+	conn, rw, err := h.Hijack()
+	if err == nil {
+		w.hijacked = true
+	}
+	return conn, rw, err
+}
+
+// Push implements http.Pusher.
+func (w *responseWriter) Push(target string, opts *http.PushOptions) error {
+	p, ok := w.ResponseWriter.(http.Pusher)
+	if !ok {
+		return http.ErrNotSupported
+	}
+	return p.Push(target, opts)
+}
+
+// Unwrap returns the original http.ResponseWriter for http.ResponseController.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httplog
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/shimt/go-logif/gologif"
+)
+
+func Test_NewAccessLog(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			http.NotFound(w, r)
+		case "/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			io.WriteString(w, "hello")
+		}
+	})
+
+	tests := []struct {
+		name   string
+		format Format
+		path   string
+		want   string
+	}{
+		{"default", Default, "/index.html?q=1", `^\[INFO\] GET /index.html 200 5 \S+ 192.0.2.1:1234 "test/1.0"\n$`},
+		{"warn", Default, "/missing", `^\[WARN\] GET /missing 404 19 `},
+		{"error", Default, "/broken", `^\[ERROR\] GET /broken 500 0 `},
+		{"escaped", Default, "/a%0A[ERROR]%20db%20down", `^\[INFO\] GET /a%0A\S*%20db%20down 200 5 [^\n]*\n$`},
+		{"common", Common, "/index.html?q=1", `^\[INFO\] 192.0.2.1 - alice \[\d\d/\w{3}/\d{4}:\d\d:\d\d:\d\d [+-]\d{4}\] "GET /index.html\?q=1 HTTP/1.1" 200 5\n$`},
+		{"common-empty", Common, "/broken", `"GET /broken HTTP/1.1" 500 -\n$`},
+		{"combined", Combined, "/index.html", `"GET /index.html HTTP/1.1" 200 5 "http://example.com/" "test/1.0"\n$`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &bytes.Buffer{}
+			l := gologif.New(b, "", 0)
+			l.SetOutputLevel(gologif.INFO)
+
+			r := httptest.NewRequest("GET", tt.path, nil)
+			r.SetBasicAuth("alice", "secret")
+			r.Header.Set("User-Agent", "test/1.0")
+			r.Header.Set("Referer", "http://example.com/")
+
+			NewAccessLog(l, tt.format, handler).ServeHTTP(httptest.NewRecorder(), r)
+
+			if !regexp.MustCompile(tt.want).MatchString(b.String()) {
+				t.Errorf("got = %q, want match %q", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func Test_NewAccessLog_disabled(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := gologif.New(b, "", 0)
+
+	h := NewAccessLog(l, Default, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+
+	if b.Len() != 0 {
+		t.Errorf("got = %q, want no output below WARN", b.String())
+	}
+}
+
+func Test_NewAccessLog_panic(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := gologif.New(b, "", 0)
+
+	h := NewAccessLog(l, Default, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	func() {
+		defer func() {
+			if v := recover(); v != "boom" {
+				t.Errorf("recover() = %v, want boom", v)
+			}
+		}()
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/panic", nil))
+	}()
+
+	if want := `^\[ERROR\] GET /panic 500 0 `; !regexp.MustCompile(want).MatchString(b.String()) {
+		t.Errorf("got = %q, want match %q", b.String(), want)
+	}
+}
+
+// hijackRecorder is a ResponseRecorder which can be hijacked.
+type hijackRecorder struct {
+	*httptest.ResponseRecorder
+	conn net.Conn
+}
+
+func (w *hijackRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return w.conn, bufio.NewReadWriter(bufio.NewReader(w.conn), bufio.NewWriter(w.conn)), nil
+}
+
+func Test_NewAccessLog_hijack(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := gologif.New(b, "", 0)
+	l.SetOutputLevel(gologif.INFO)
+
+	c1, c2 := net.Pipe()
+	defer c1.Close()
+	defer c2.Close()
+
+	h := NewAccessLog(l, Default, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, _, err := w.(http.Hijacker).Hijack(); err != nil {
+			t.Fatal(err)
+		}
+	}))
+	h.ServeHTTP(&hijackRecorder{httptest.NewRecorder(), c1}, httptest.NewRequest("GET", "/ws", nil))
+
+	if want := `^\[INFO\] GET /ws 101 0 `; !regexp.MustCompile(want).MatchString(b.String()) {
+		t.Errorf("got = %q, want match %q", b.String(), want)
+	}
+}
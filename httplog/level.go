@@ -0,0 +1,258 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httplog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shimt/go-logif"
+)
+
+// LevelHandler is an http.Handler to view and change the output level of
+// registered loggers at runtime.
+//
+//	GET /          lists the loggers and their levels
+//	GET /{name}    shows the level of a logger
+//	PUT /{name}    changes the level of a logger
+//
+// A PUT body is either a level name (text/plain) or a JSON object
+// {"level": "DEBUG", "revert": "10m"} (application/json). The revert
+// duration can also be given by the "revert" query parameter; when set,
+// the previous level is restored after the duration.
+//
+// Responses are JSON when the request accepts or sends application/json,
+// and plain text otherwise. Mount the handler with http.StripPrefix.
+type LevelHandler struct {
+	mu      sync.Mutex
+	loggers map[string]*levelEntry
+}
+
+type levelEntry struct {
+	logger   logif.LeveledLoggerModifier
+	timer    *time.Timer
+	gen      int // incremented by every change, to identify the timer
+	previous logif.LogLevel
+	revertAt time.Time
+}
+
+// levelStatus is the JSON representation of a logger.
+type levelStatus struct {
+	Name        string          `json:"name"`
+	Level       logif.LogLevel  `json:"level"`
+	RevertLevel *logif.LogLevel `json:"revert_level,omitempty"`
+	RevertAt    *time.Time      `json:"revert_at,omitempty"`
+}
+
+// levelRequest is the JSON body of a PUT request.
+type levelRequest struct {
+	Level  *logif.LogLevel `json:"level"`
+	Revert string          `json:"revert"`
+}
+
+// verify interface compliance.
+var _ http.Handler = (*LevelHandler)(nil)
+
+// NewLevelHandler creates an empty LevelHandler.
+func NewLevelHandler() *LevelHandler {
+	return &LevelHandler{
+		loggers: map[string]*levelEntry{},
+	}
+}
+
+// Register adds l to the handler as name, replacing a logger of the same name.
+func (h *LevelHandler) Register(name string, l logif.LeveledLoggerModifier) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if e, ok := h.loggers[name]; ok && e.timer != nil {
+		e.timer.Stop()
+	}
+	h.loggers[name] = &levelEntry{logger: l}
+}
+
+// SetLevel changes the level of the logger registered as name.
+// When revert is positive, the current level is restored after revert.
+func (h *LevelHandler) SetLevel(name string, level logif.LogLevel, revert time.Duration) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	e, ok := h.loggers[name]
+	if !ok {
+		return fmt.Errorf("httplog: unknown logger %q", name)
+	}
+
+	if e.timer != nil {
+		e.timer.Stop()
+		e.timer = nil
+	} else {
+		e.previous = e.logger.OutputLevel()
+	}
+
+	e.logger.SetOutputLevel(level)
+	e.gen++
+
+	if revert > 0 {
+		gen := e.gen
+		e.revertAt = time.Now().Add(revert)
+		e.timer = time.AfterFunc(revert, func() { h.revert(name, e, gen) })
+	}
+
+	return nil
+}
+
+// revert restores the previous level of e unless it was changed again
+// after the change gen. A timer stopped too late to prevent its function
+// from running is thus ignored.
+func (h *LevelHandler) revert(name string, e *levelEntry, gen int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.loggers[name] != e || e.gen != gen || e.timer == nil {
+		return
+	}
+
+	e.logger.SetOutputLevel(e.previous)
+	e.timer = nil
+}
+
+// ServeHTTP implements http.Handler.
+func (h *LevelHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := strings.Trim(r.URL.Path, "/")
+	useJSON := strings.Contains(r.Header.Get("Accept"), "application/json") ||
+		strings.HasPrefix(r.Header.Get("Content-Type"), "application/json")
+
+	switch {
+	case r.Method == http.MethodGet && name == "":
+		h.writeStatus(w, useJSON, true, h.status()...)
+	case r.Method == http.MethodGet:
+		s, ok := h.statusOf(name)
+		if !ok {
+			http.Error(w, fmt.Sprintf("unknown logger %q", name), http.StatusNotFound)
+			return
+		}
+		h.writeStatus(w, useJSON, false, s)
+	case r.Method == http.MethodPut && name != "":
+		h.put(w, r, name, useJSON)
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *LevelHandler) put(w http.ResponseWriter, r *http.Request, name string, useJSON bool) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, 4096))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var req levelRequest
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		err = json.Unmarshal(body, &req)
+		if err == nil && req.Level == nil {
+			err = errors.New("missing level")
+		}
+	} else {
+		req.Level = new(logif.LogLevel)
+		err = req.Level.UnmarshalText(bytes.TrimSpace(body))
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if q := r.URL.Query().Get("revert"); q != "" {
+		req.Revert = q
+	}
+
+	var revert time.Duration
+	if req.Revert != "" {
+		if revert, err = time.ParseDuration(req.Revert); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if err := h.SetLevel(name, *req.Level, revert); err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	s, _ := h.statusOf(name)
+	h.writeStatus(w, useJSON, false, s)
+}
+
+// status returns the state of every logger sorted by name.
+func (h *LevelHandler) status() []levelStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	r := make([]levelStatus, 0, len(h.loggers))
+	for name, e := range h.loggers {
+		r = append(r, e.status(name))
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i].Name < r[j].Name })
+
+	return r
+}
+
+func (h *LevelHandler) statusOf(name string) (levelStatus, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	e, ok := h.loggers[name]
+	if !ok {
+		return levelStatus{}, false
+	}
+
+	return e.status(name), true
+}
+
+func (e *levelEntry) status(name string) levelStatus {
+	s := levelStatus{
+		Name:  name,
+		Level: e.logger.OutputLevel(),
+	}
+
+	if e.timer != nil {
+		previous, at := e.previous, e.revertAt
+		s.RevertLevel = &previous
+		s.RevertAt = &at
+	}
+
+	return s
+}
+
+// writeStatus writes s as JSON or plain text; a JSON list is written as an
+// array, a single logger as an object.
+func (h *LevelHandler) writeStatus(w http.ResponseWriter, useJSON bool, list bool, s ...levelStatus) {
+	if useJSON {
+		w.Header().Set("Content-Type", "application/json")
+		if list {
+			json.NewEncoder(w).Encode(s)
+		} else {
+			json.NewEncoder(w).Encode(s[0])
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	for _, v := range s {
+		if v.RevertLevel != nil {
+			fmt.Fprintf(w, "%s %v (revert to %v at %s)\n", v.Name, v.Level, *v.RevertLevel, v.RevertAt.Format(time.RFC3339))
+		} else {
+			fmt.Fprintf(w, "%s %v\n", v.Name, v.Level)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package httplog
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shimt/go-logif"
+	"github.com/shimt/go-logif/gologif"
+)
+
+func Test_LevelHandler(t *testing.T) {
+	h := NewLevelHandler()
+	app := gologif.New(ioutil.Discard, "", 0)
+	db := gologif.New(ioutil.Discard, "", 0)
+	h.Register("app", app)
+	h.Register("db", db)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		accept      string
+		body        string
+		wantCode    int
+		wantBody    string
+	}{
+		{"list", "GET", "/", "", "", "", 200, "app WARN\ndb WARN\n"},
+		{"list-json", "GET", "/", "", "application/json", "", 200, `[{"name":"app","level":"WARN"},{"name":"db","level":"WARN"}]` + "\n"},
+		{"put-text", "PUT", "/app", "text/plain", "", "debug\n", 200, "app DEBUG\n"},
+		{"put-json", "PUT", "/db", "application/json", "", `{"level":"ERROR"}`, 200, `{"name":"db","level":"ERROR"}` + "\n"},
+		{"get", "GET", "/app", "", "", "", 200, "app DEBUG\n"},
+		{"unknown", "GET", "/none", "", "", "", 404, ""},
+		{"put-unknown", "PUT", "/none", "text/plain", "", "INFO", 404, ""},
+		{"bad-level", "PUT", "/app", "text/plain", "", "LOUD", 400, ""},
+		{"missing-level", "PUT", "/app", "application/json", "", `{}`, 400, ""},
+		{"bad-revert", "PUT", "/app?revert=soon", "text/plain", "", "INFO", 400, ""},
+		{"method", "DELETE", "/app", "", "", "", 405, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, r)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("code = %v, want %v (%s)", w.Code, tt.wantCode, w.Body.String())
+			}
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+
+	if app.OutputLevel() != logif.DEBUG || db.OutputLevel() != logif.ERROR {
+		t.Errorf("levels = %v, %v, want DEBUG, ERROR", app.OutputLevel(), db.OutputLevel())
+	}
+}
+
+func Test_LevelHandler_revert(t *testing.T) {
+	h := NewLevelHandler()
+	l := gologif.New(ioutil.Discard, "", 0)
+	h.Register("app", l)
+
+	r := httptest.NewRequest("PUT", "/app?revert=20ms", strings.NewReader("DEBUG"))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if got := w.Body.String(); !strings.HasPrefix(got, "app DEBUG (revert to WARN at ") {
+		t.Errorf("body = %q", got)
+	}
+
+	// a second change keeps the level to revert to.
+	if err := h.SetLevel("app", logif.INFO, 20*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for l.OutputLevel() != logif.WARN {
+		if time.Now().After(deadline) {
+			t.Fatalf("level = %v, want WARN after revert", l.OutputLevel())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/app", nil))
+	if got, want := w.Body.String(), "app WARN\n"; got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
+
+func Test_LevelHandler_revert_stale(t *testing.T) {
+	h := NewLevelHandler()
+	l := gologif.New(ioutil.Discard, "", 0)
+	h.Register("app", l)
+
+	if err := h.SetLevel("app", logif.DEBUG, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	e := h.loggers["app"]
+	stale := e.gen
+
+	if err := h.SetLevel("app", logif.INFO, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	defer e.timer.Stop()
+
+	// the function of the first timer, run after it was replaced, must not
+	// revert the second change.
+	h.revert("app", e, stale)
+	if l.OutputLevel() != logif.INFO {
+		t.Errorf("level = %v, want INFO", l.OutputLevel())
+	}
+
+	h.revert("app", e, e.gen)
+	if l.OutputLevel() != logif.WARN {
+		t.Errorf("level = %v, want WARN", l.OutputLevel())
+	}
+}
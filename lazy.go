@@ -0,0 +1,86 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package logif
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Lazy is a log argument computed only when the message is actually written.
+//
+//	l.Debugf("state: %v", logif.Lazy(func() interface{} { return dump(s) }))
+//
+// Leveled loggers evaluate Lazy arguments after the level check passes,
+// so a disabled level never calls the function.
+type Lazy func() interface{}
+
+// Format implements fmt.Formatter so that a Lazy argument is evaluated even
+// by loggers that do not know about it.
+func (f Lazy) Format(s fmt.State, verb rune) {
+	fmt.Fprintf(s, formatDirective(s, verb), f())
+}
+
+// String returns the value of f formatted in the manner of fmt.Sprint.
+func (f Lazy) String() string {
+	return fmt.Sprint(f())
+}
+
+// ResolveLazy returns v with every Lazy and func() interface{} argument
+// replaced by its result.
+// v is returned as is when it has nothing to evaluate.
+func ResolveLazy(v []interface{}) []interface{} {
+	for i := range v {
+		switch v[i].(type) {
+		case Lazy, func() interface{}:
+			return resolveLazy(v, i)
+		}
+	}
+
+	return v
+}
+
+func resolveLazy(v []interface{}, i int) []interface{} {
+	r := make([]interface{}, len(v))
+	copy(r, v[:i])
+
+	for ; i < len(v); i++ {
+		switch f := v[i].(type) {
+		case Lazy:
+			r[i] = f()
+		case func() interface{}:
+			r[i] = f()
+		default:
+			r[i] = f
+		}
+	}
+
+	return r
+}
+
+// formatDirective rebuilds the verb (e.g. "%-8.3f") that s was created from.
+func formatDirective(s fmt.State, verb rune) string {
+	b := make([]byte, 0, 16)
+	b = append(b, '%')
+
+	for _, c := range "+-# 0" {
+		if s.Flag(int(c)) {
+			b = append(b, byte(c))
+		}
+	}
+
+	if w, ok := s.Width(); ok {
+		b = strconv.AppendInt(b, int64(w), 10)
+	}
+
+	if p, ok := s.Precision(); ok {
+		b = append(b, '.')
+		b = strconv.AppendInt(b, int64(p), 10)
+	}
+
+	b = append(b, string(verb)...)
+
+	return string(b)
+}
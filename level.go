@@ -0,0 +1,157 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package logif
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// builtinLevels are the levels defined by the package in ascending order.
+var builtinLevels = []LogLevel{DEBUG, INFO, WARN, ERROR}
+
+// registry holds the custom levels.
+type registry struct {
+	names  map[LogLevel]string
+	levels []LogLevel // built-in and custom levels in ascending order
+}
+
+var (
+	registryMu sync.Mutex   // serializes RegisterLevel
+	registered atomic.Value // *registry
+)
+
+func init() {
+	registered.Store(&registry{levels: builtinLevels})
+}
+
+func loadRegistry() *registry {
+	return registered.Load().(*registry)
+}
+
+// RegisterLevel defines a custom level named name, such as
+//
+//	const CRITICAL = logif.ERROR + 1
+//
+//	func init() {
+//		logif.RegisterLevel(CRITICAL, "CRITICAL")
+//	}
+//
+// The value of level gives its place in the ordering of levels; it must not
+// be used by another level nor be OFF or math.MinInt32, which gologif uses
+// for untagged records. The name, compared case-insensitively, must be
+// unique. Custom levels are known to String, ParseLogLevel and Levels.
+func RegisterLevel(level LogLevel, name string) error {
+	if name == "" || strings.ContainsAny(name, " \t\r\n") {
+		return fmt.Errorf("logif: invalid level name %q", name)
+	}
+	if level == OFF || level == math.MinInt32 {
+		return fmt.Errorf("logif: level %d is reserved", int32(level))
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	r := loadRegistry()
+	for _, l := range r.levels {
+		if l == level {
+			return fmt.Errorf("logif: level %d is already defined as %v", int32(level), l)
+		}
+		if strings.EqualFold(name, l.String()) {
+			return fmt.Errorf("logif: level name %q is already defined", name)
+		}
+	}
+	if strings.EqualFold(name, OFF.String()) {
+		return fmt.Errorf("logif: level name %q is already defined", name)
+	}
+
+	n := &registry{
+		names:  make(map[LogLevel]string, len(r.names)+1),
+		levels: append(append([]LogLevel(nil), r.levels...), level),
+	}
+	for k, v := range r.names {
+		n.names[k] = v
+	}
+	n.names[level] = name
+	sort.Slice(n.levels, func(i, j int) bool { return n.levels[i] < n.levels[j] })
+
+	registered.Store(n)
+	return nil
+}
+
+// UnregisterLevel removes a custom level defined by RegisterLevel. It is
+// meant for tests which register levels of their own.
+func UnregisterLevel(level LogLevel) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	r := loadRegistry()
+	if _, ok := r.names[level]; !ok {
+		return fmt.Errorf("logif: level %d is not a custom level", int32(level))
+	}
+
+	n := &registry{
+		names:  make(map[LogLevel]string, len(r.names)-1),
+		levels: make([]LogLevel, 0, len(r.levels)-1),
+	}
+	for k, v := range r.names {
+		if k != level {
+			n.names[k] = v
+		}
+	}
+	for _, l := range r.levels {
+		if l != level {
+			n.levels = append(n.levels, l)
+		}
+	}
+
+	registered.Store(n)
+	return nil
+}
+
+// Levels returns the built-in and custom levels in ascending order.
+// OFF is not included.
+func Levels() []LogLevel {
+	return append([]LogLevel(nil), loadRegistry().levels...)
+}
+
+// ClampLevel returns level if it is a built-in or custom level or OFF, and
+// otherwise the known level next above it, or OFF if there is none.
+func ClampLevel(level LogLevel) LogLevel {
+	for _, v := range loadRegistry().levels {
+		if v >= level {
+			return v
+		}
+	}
+
+	return OFF
+}
+
+// String returns the name of the level, or "LogLevel(n)" for an unknown level.
+func (l LogLevel) String() string {
+	switch l {
+	case DEBUG:
+		return "DEBUG"
+	case INFO:
+		return "INFO"
+	case WARN:
+		return "WARN"
+	case ERROR:
+		return "ERROR"
+	case OFF:
+		return "OFF"
+	}
+
+	if name, ok := loadRegistry().names[l]; ok {
+		return name
+	}
+
+	return "LogLevel(" + strconv.FormatInt(int64(l), 10) + ")"
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package logif
+
+import (
+	"testing"
+)
+
+func Test_ClampLevel(t *testing.T) {
+	const critical = ERROR + 10
+	if err := RegisterLevel(critical, "CRITICAL"); err != nil {
+		t.Fatal(err)
+	}
+	defer UnregisterLevel(critical)
+
+	tests := []struct {
+		level LogLevel
+		want  LogLevel
+	}{
+		{DEBUG, DEBUG},
+		{1, INFO},
+		{-5, DEBUG},
+		{ERROR + 1, critical},
+		{critical, critical},
+		{critical + 1, OFF},
+		{OFF, OFF},
+	}
+	for _, tt := range tests {
+		if got := ClampLevel(tt.level); got != tt.want {
+			t.Errorf("ClampLevel(%v) = %v, want %v", tt.level, got, tt.want)
+		}
+	}
+}
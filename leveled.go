@@ -0,0 +1,267 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package logif
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// outputter is implemented by loggers, such as *log.Logger, which can report
+// the file and line number of a caller calldepth frames above.
+type outputter interface {
+	Output(calldepth int, s string) error
+}
+
+// Leveled adds levels to any Logger, such as a plain *log.Logger or a
+// third-party implementation.
+//
+// Leveled messages are written with a level tag such as "[WARN] " before
+// the message, and only when their level is at least the output level,
+// as gologif does. The Print family is written without a tag at every
+// output level but OFF, and the Fatal and Panic families are passed
+// through to the Logger as they are.
+type Leveled struct {
+	Logger
+
+	outputLevel int32
+}
+
+var (
+	_ LeveledLogger         = (*Leveled)(nil)
+	_ LeveledLoggerModifier = (*Leveled)(nil)
+	_ DepthLogger           = (*Leveled)(nil)
+)
+
+// NewLeveled returns l with levels. The output level is WARN.
+//
+// When l has an Output(calldepth int, s string) error method, as *log.Logger
+// does, messages are written with it so that the file and line number point
+// to the caller of the leveled method; otherwise they are written with Print.
+func NewLeveled(l Logger) *Leveled {
+	return &Leveled{
+		Logger:      l,
+		outputLevel: int32(WARN),
+	}
+}
+
+// SetOutputLevel set output level
+//
+// OFF silences the logger, including the Print family. A level which is
+// neither a built-in nor a custom level is clamped with ClampLevel.
+func (l *Leveled) SetOutputLevel(level LogLevel) {
+	atomic.StoreInt32(&l.outputLevel, int32(ClampLevel(level)))
+}
+
+// OutputLevel get output level
+func (l *Leveled) OutputLevel() LogLevel {
+	return LogLevel(atomic.LoadInt32(&l.outputLevel))
+}
+
+// Enabled reports whether a message of the level is written to the logger.
+func (l *Leveled) Enabled(level LogLevel) bool {
+	return level >= l.OutputLevel() && level != OFF
+}
+
+// output writes s with the tag of level. It must be called directly by the
+// leveled methods for the calldepth to point to depth frames above their
+// caller, as write must be by the Print family.
+func (l *Leveled) output(depth int, level LogLevel, s string) {
+	l.write(4+depth, "["+level.String()+"] "+s)
+}
+
+// write writes s with Output at calldepth, or else with Print.
+func (l *Leveled) write(calldepth int, s string) {
+	if o, ok := l.Logger.(outputter); ok {
+		o.Output(calldepth, s)
+		return
+	}
+
+	l.Logger.Print(s)
+}
+
+// Print writes to the logger unless the output level is OFF.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Leveled) Print(v ...interface{}) {
+	if l.OutputLevel() != OFF {
+		l.write(3, fmt.Sprint(ResolveLazy(v)...))
+	}
+}
+
+// Printf writes to the logger unless the output level is OFF.
+// Arguments are handled in the manner of fmt.Printf.
+func (l *Leveled) Printf(format string, v ...interface{}) {
+	if l.OutputLevel() != OFF {
+		l.write(3, fmt.Sprintf(format, ResolveLazy(v)...))
+	}
+}
+
+// Println writes to the logger unless the output level is OFF.
+// Arguments are handled in the manner of fmt.Println.
+func (l *Leveled) Println(v ...interface{}) {
+	if l.OutputLevel() != OFF {
+		l.write(3, fmt.Sprintln(ResolveLazy(v)...))
+	}
+}
+
+// Log write message(level=level) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Leveled) Log(level LogLevel, v ...interface{}) {
+	if l.Enabled(level) {
+		l.output(0, level, fmt.Sprint(ResolveLazy(v)...))
+	}
+}
+
+// Logf write message(level=level) to the logger.
+// Arguments are handled in the manner of fmt.Printf.
+func (l *Leveled) Logf(level LogLevel, format string, v ...interface{}) {
+	if l.Enabled(level) {
+		l.output(0, level, fmt.Sprintf(format, ResolveLazy(v)...))
+	}
+}
+
+// Logln write message(level=level) to the logger.
+// Arguments are handled in the manner of fmt.Println.
+func (l *Leveled) Logln(level LogLevel, v ...interface{}) {
+	if l.Enabled(level) {
+		l.output(0, level, fmt.Sprintln(ResolveLazy(v)...))
+	}
+}
+
+// Debug write message(level=DEBUG) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Leveled) Debug(v ...interface{}) {
+	if l.Enabled(DEBUG) {
+		l.output(0, DEBUG, fmt.Sprint(ResolveLazy(v)...))
+	}
+}
+
+// Debugf write message(level=DEBUG) to the logger.
+// Arguments are handled in the manner of fmt.Printf.
+func (l *Leveled) Debugf(format string, v ...interface{}) {
+	if l.Enabled(DEBUG) {
+		l.output(0, DEBUG, fmt.Sprintf(format, ResolveLazy(v)...))
+	}
+}
+
+// Debugln write message(level=DEBUG) to the logger.
+// Arguments are handled in the manner of fmt.Println.
+func (l *Leveled) Debugln(v ...interface{}) {
+	if l.Enabled(DEBUG) {
+		l.output(0, DEBUG, fmt.Sprintln(ResolveLazy(v)...))
+	}
+}
+
+// Info write message(level=INFO) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Leveled) Info(v ...interface{}) {
+	if l.Enabled(INFO) {
+		l.output(0, INFO, fmt.Sprint(ResolveLazy(v)...))
+	}
+}
+
+// Infof write message(level=INFO) to the logger.
+// Arguments are handled in the manner of fmt.Printf.
+func (l *Leveled) Infof(format string, v ...interface{}) {
+	if l.Enabled(INFO) {
+		l.output(0, INFO, fmt.Sprintf(format, ResolveLazy(v)...))
+	}
+}
+
+// Infoln write message(level=INFO) to the logger.
+// Arguments are handled in the manner of fmt.Println.
+func (l *Leveled) Infoln(v ...interface{}) {
+	if l.Enabled(INFO) {
+		l.output(0, INFO, fmt.Sprintln(ResolveLazy(v)...))
+	}
+}
+
+// Warn write message(level=WARN) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Leveled) Warn(v ...interface{}) {
+	if l.Enabled(WARN) {
+		l.output(0, WARN, fmt.Sprint(ResolveLazy(v)...))
+	}
+}
+
+// Warnf write message(level=WARN) to the logger.
+// Arguments are handled in the manner of fmt.Printf.
+func (l *Leveled) Warnf(format string, v ...interface{}) {
+	if l.Enabled(WARN) {
+		l.output(0, WARN, fmt.Sprintf(format, ResolveLazy(v)...))
+	}
+}
+
+// Warnln write message(level=WARN) to the logger.
+// Arguments are handled in the manner of fmt.Println.
+func (l *Leveled) Warnln(v ...interface{}) {
+	if l.Enabled(WARN) {
+		l.output(0, WARN, fmt.Sprintln(ResolveLazy(v)...))
+	}
+}
+
+// Error write message(level=ERROR) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Leveled) Error(v ...interface{}) {
+	if l.Enabled(ERROR) {
+		l.output(0, ERROR, fmt.Sprint(ResolveLazy(v)...))
+	}
+}
+
+// Errorf write message(level=ERROR) to the logger.
+// Arguments are handled in the manner of fmt.Printf.
+func (l *Leveled) Errorf(format string, v ...interface{}) {
+	if l.Enabled(ERROR) {
+		l.output(0, ERROR, fmt.Sprintf(format, ResolveLazy(v)...))
+	}
+}
+
+// Errorln write message(level=ERROR) to the logger.
+// Arguments are handled in the manner of fmt.Println.
+func (l *Leveled) Errorln(v ...interface{}) {
+	if l.Enabled(ERROR) {
+		l.output(0, ERROR, fmt.Sprintln(ResolveLazy(v)...))
+	}
+}
+
+// LogDepth write message(level=level) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Leveled) LogDepth(depth int, level LogLevel, v ...interface{}) {
+	if l.Enabled(level) {
+		l.output(depth, level, fmt.Sprint(ResolveLazy(v)...))
+	}
+}
+
+// DebugDepth write message(level=DEBUG) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Leveled) DebugDepth(depth int, v ...interface{}) {
+	if l.Enabled(DEBUG) {
+		l.output(depth, DEBUG, fmt.Sprint(ResolveLazy(v)...))
+	}
+}
+
+// InfoDepth write message(level=INFO) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Leveled) InfoDepth(depth int, v ...interface{}) {
+	if l.Enabled(INFO) {
+		l.output(depth, INFO, fmt.Sprint(ResolveLazy(v)...))
+	}
+}
+
+// WarnDepth write message(level=WARN) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Leveled) WarnDepth(depth int, v ...interface{}) {
+	if l.Enabled(WARN) {
+		l.output(depth, WARN, fmt.Sprint(ResolveLazy(v)...))
+	}
+}
+
+// ErrorDepth write message(level=ERROR) to the logger.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Leveled) ErrorDepth(depth int, v ...interface{}) {
+	if l.Enabled(ERROR) {
+		l.output(depth, ERROR, fmt.Sprint(ResolveLazy(v)...))
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package logif
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"regexp"
+	"runtime"
+	"testing"
+)
+
+// printOnly is a Logger without an Output method.
+type printOnly struct {
+	Logger
+}
+
+func Test_Leveled(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := NewLeveled(log.New(b, "", 0))
+
+	if l.OutputLevel() != WARN {
+		t.Errorf("OutputLevel() = %v, want WARN", l.OutputLevel())
+	}
+
+	l.Debug("debug")
+	l.Info("info")
+	l.Warn("warn")
+	l.Errorf("error %d", 1)
+	l.Log(INFO, "log")
+	l.Print("print")
+
+	want := "[WARN] warn\n[ERROR] error 1\nprint\n"
+	if got := b.String(); got != want {
+		t.Errorf("got = %q, want %q", got, want)
+	}
+
+	b.Reset()
+	l.SetOutputLevel(DEBUG)
+	l.Debugln("debug", 1)
+	l.Logf(INFO, "log %d", 2)
+	if want := "[DEBUG] debug 1\n[INFO] log 2\n"; b.String() != want {
+		t.Errorf("got = %q, want %q", b.String(), want)
+	}
+
+	b.Reset()
+	l.SetOutputLevel(OFF)
+	l.Error("error")
+	l.Print("print")
+	l.Printf("printf")
+	l.Println("println")
+	if l.Enabled(OFF) || b.Len() != 0 {
+		t.Errorf("got = %q, want no output at OFF", b.String())
+	}
+
+	// an unknown level is clamped as gologif does.
+	l.SetOutputLevel(1)
+	if got := l.OutputLevel(); got != INFO {
+		t.Errorf("OutputLevel() = %v, want INFO", got)
+	}
+	l.SetOutputLevel(ERROR + 1)
+	if got := l.OutputLevel(); got != OFF {
+		t.Errorf("OutputLevel() = %v, want OFF", got)
+	}
+}
+
+func Test_Leveled_calldepth(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := NewLeveled(log.New(b, "", log.Lshortfile))
+
+	_, _, line, _ := runtime.Caller(0)
+	l.Warn("warn")
+	l.Errorf("error")
+	helper(l)
+	l.Print("print")
+
+	want := fmt.Sprintf("leveled_test.go:%d: [WARN] warn\n", line+1) +
+		fmt.Sprintf("leveled_test.go:%d: [ERROR] error\n", line+2) +
+		fmt.Sprintf("leveled_test.go:%d: [WARN] helper\n", line+3) +
+		fmt.Sprintf("leveled_test.go:%d: print\n", line+4)
+	if got := b.String(); got != want {
+		t.Errorf("got = %q, want %q", got, want)
+	}
+}
+
+func helper(l DepthLogger) {
+	l.WarnDepth(1, "helper")
+}
+
+func Test_Leveled_print(t *testing.T) {
+	b := &bytes.Buffer{}
+	l := NewLeveled(printOnly{log.New(b, "", log.Lshortfile)})
+
+	l.Warn("warn")
+	l.WarnDepth(1, "depth")
+
+	// without Output, the messages are written with Print, and the file
+	// and line number are those of Leveled.
+	re := regexp.MustCompile(`^leveled.go:\d+: \[WARN\] warn\nleveled.go:\d+: \[WARN\] depth\n$`)
+	if got := b.String(); !re.MatchString(got) {
+		t.Errorf("got = %q, want match %q", got, re)
+	}
+}
@@ -5,9 +5,12 @@
 // Package logif is logging interface
 package logif
 
-import "io"
-
-//go:generate stringer -type=LogLevel
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
 
 // LogLevel importance of the message.
 //
@@ -17,6 +20,8 @@ import "io"
 // 2) INFO
 // 3) WARN
 // 4) ERROR
+//
+// Applications may define more levels with RegisterLevel.
 type LogLevel int32
 
 const (
@@ -34,6 +39,40 @@ const (
 	MAXLEVEL LogLevel = iota - 1
 )
 
+// OFF is above every level. An output level of OFF silences the logger.
+const OFF LogLevel = math.MaxInt32
+
+// ParseLogLevel returns the level named s, case-insensitive.
+// OFF and custom levels are accepted.
+func ParseLogLevel(s string) (LogLevel, error) {
+	for _, l := range loadRegistry().levels {
+		if strings.EqualFold(s, l.String()) {
+			return l, nil
+		}
+	}
+	if strings.EqualFold(s, OFF.String()) {
+		return OFF, nil
+	}
+
+	return 0, fmt.Errorf("logif: unknown log level %q", s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l LogLevel) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (l *LogLevel) UnmarshalText(text []byte) error {
+	v, err := ParseLogLevel(string(text))
+	if err != nil {
+		return err
+	}
+
+	*l = v
+	return nil
+}
+
 // Logger minimum logging interface
 type Logger interface {
 	// Print calls l.Output to print to the logger. Arguments are handled in the manner of fmt.Print.
@@ -84,6 +123,19 @@ type LoggerModifier interface {
 
 //LeveledLogger leveld logging interface
 type LeveledLogger interface {
+	// Enabled reports whether a message of the level is written to the logger.
+	Enabled(level LogLevel) bool
+
+	// Log write message(level=level) to the logger.
+	// Arguments are handled in the manner of fmt.Print.
+	Log(level LogLevel, v ...interface{})
+	// Logf write message(level=level) to the logger.
+	// Arguments are handled in the manner of fmt.Printf.
+	Logf(level LogLevel, format string, v ...interface{})
+	// Logln write message(level=level) to the logger.
+	// Arguments are handled in the manner of fmt.Println.
+	Logln(level LogLevel, v ...interface{})
+
 	// Debug write message(level=DEBUG) to the logger.
 	// Arguments are handled in the manner of fmt.Print.
 	Debug(v ...interface{})
@@ -125,6 +177,29 @@ type LeveledLogger interface {
 	Errorln(v ...interface{})
 }
 
+//DepthLogger leveled logging interface for helper functions.
+//
+// depth is the number of stack frames to skip when the file and line number
+// are printed; 0 identifies the caller of the method, 1 its caller, and so on.
+type DepthLogger interface {
+	// LogDepth write message(level=level) to the logger.
+	// Arguments are handled in the manner of fmt.Print.
+	LogDepth(depth int, level LogLevel, v ...interface{})
+
+	// DebugDepth write message(level=DEBUG) to the logger.
+	// Arguments are handled in the manner of fmt.Print.
+	DebugDepth(depth int, v ...interface{})
+	// InfoDepth write message(level=INFO) to the logger.
+	// Arguments are handled in the manner of fmt.Print.
+	InfoDepth(depth int, v ...interface{})
+	// WarnDepth write message(level=WARN) to the logger.
+	// Arguments are handled in the manner of fmt.Print.
+	WarnDepth(depth int, v ...interface{})
+	// ErrorDepth write message(level=ERROR) to the logger.
+	// Arguments are handled in the manner of fmt.Print.
+	ErrorDepth(depth int, v ...interface{})
+}
+
 //LeveledLoggerModifier leveld logging modifier interface
 type LeveledLoggerModifier interface {
 	// SetOutputLevel set output level
@@ -0,0 +1,40 @@
+// Copyright 2026 Shinichi MOTOKI. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package logif
+
+import (
+	"fmt"
+	"io"
+)
+
+// Redacted is the text written in place of a secret.
+const Redacted = "[REDACTED]"
+
+// Secret is a string that is never written in clear text.
+//
+//	l.Printf("login user=%s password=%s", user, logif.Secret(password))
+//
+// Every fmt verb, String, GoString and MarshalText render it as Redacted.
+type Secret string
+
+// Format implements fmt.Formatter.
+func (Secret) Format(s fmt.State, verb rune) {
+	io.WriteString(s, Redacted)
+}
+
+// String implements fmt.Stringer.
+func (Secret) String() string {
+	return Redacted
+}
+
+// GoString implements fmt.GoStringer.
+func (Secret) GoString() string {
+	return Redacted
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (Secret) MarshalText() ([]byte, error) {
+	return []byte(Redacted), nil
+}